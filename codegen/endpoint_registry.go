@@ -12,7 +12,8 @@ var endpointRegistry = map[string]*additionalInfo{
 		Type: tfTypeResource,
 	},
 	"/transform/decode/{role_name}": {
-		Type: tfTypeDataSource,
+		Type:                tfTypeDataSource,
+		SensitiveParameters: []string{"batch_results", "decoded_value"},
 		AdditionalParameters: []templatableParam{
 			{
 				OASParameter: &framework.OASParameter{
@@ -42,7 +43,8 @@ var endpointRegistry = map[string]*additionalInfo{
 		},
 	},
 	"/transform/encode/{role_name}": {
-		Type: tfTypeDataSource,
+		Type:                tfTypeDataSource,
+		SensitiveParameters: []string{"batch_input", "value"},
 		AdditionalParameters: []templatableParam{
 			{
 				OASParameter: &framework.OASParameter{
@@ -135,4 +137,7 @@ func (t tfType) String() string {
 type additionalInfo struct {
 	Type                 tfType
 	AdditionalParameters []templatableParam
+	// SensitiveParameters are the names of parameters to mark as sensitive,
+	// in addition to those the OpenAPI doc already displays as sensitive.
+	SensitiveParameters []string
 }
@@ -168,6 +168,18 @@ func parseParameters(endpointInfo *framework.OASPathItem, addedInfo *additionalI
 		}
 		result = result[:j+1]
 	}
+
+	for _, name := range addedInfo.SensitiveParameters {
+		for _, param := range result {
+			if param.Name != name {
+				continue
+			}
+			if param.Schema.DisplayAttrs == nil {
+				param.Schema.DisplayAttrs = &framework.DisplayAttributes{}
+			}
+			param.Schema.DisplayAttrs.Sensitive = true
+		}
+	}
 	return result
 }
 
@@ -59,6 +59,9 @@ func {{ .UpperCaseDifferentiator }}DataSource() *schema.Resource {
                 {{- if .Computed }}
                 Computed:    true,
                 {{- end }}
+				{{- if .Schema.DisplayAttrs.Sensitive }}
+				Sensitive:   true,
+				{{- end }}
 				Description: "{{ .Description }}",
 			},
 			{{- end }}
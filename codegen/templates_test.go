@@ -582,3 +582,60 @@ func TestTemplateHandler(t *testing.T) {
 		t.Fatalf("unexpected result: %s", result)
 	}
 }
+
+func TestSensitiveParameters(t *testing.T) {
+	h, err := newTemplateHandler(hclog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpointInfo := &framework.OASPathItem{}
+	if err := json.Unmarshal([]byte(`{
+	"parameters": [{
+		"name": "role_name",
+		"description": "The name of the role.",
+		"in": "path",
+		"schema": {
+			"type": "string"
+		},
+		"required": true
+	}],
+	"post": {
+		"operationId": "postTransformEncodeRoleName",
+		"requestBody": {
+			"content": {
+				"application/json": {
+					"schema": {
+						"type": "object",
+						"properties": {
+							"value": {
+								"type": "string",
+								"description": "The value in which to encode."
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`), endpointInfo); err != nil {
+		t.Fatal(err)
+	}
+	addedInfo := &additionalInfo{
+		Type:                tfTypeDataSource,
+		SensitiveParameters: []string{"value"},
+	}
+
+	for _, param := range parseParameters(endpointInfo, addedInfo) {
+		if expected := param.Name == "value"; param.Schema.DisplayAttrs.Sensitive != expected {
+			t.Fatalf("expected %q sensitive to be %t", param.Name, expected)
+		}
+	}
+
+	b := &strings.Builder{}
+	if err := h.Write(b, templateTypeDataSource, "/transform/encode/{role_name}", endpointInfo, addedInfo); err != nil {
+		t.Fatal(err)
+	}
+	if result := b.String(); strings.Count(result, "Sensitive:") != 1 {
+		t.Fatalf("expected a single sensitive field, got: %s", result)
+	}
+}
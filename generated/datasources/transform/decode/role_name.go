@@ -40,12 +40,14 @@ func RoleNameDataSource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeMap},
 				Optional:    true,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The result of decoding batch_input.",
 			},
 			"decoded_value": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The result of decoding a value.",
 			},
 			"role_name": {
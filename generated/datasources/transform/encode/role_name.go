@@ -33,6 +33,7 @@ func RoleNameDataSource() *schema.Resource {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeMap},
 				Optional:    true,
+				Sensitive:   true,
 				Description: "Specifies a list of items to be encoded in a single batch. If this parameter is set, the parameters 'value', 'transformation' and 'tweak' will be ignored. Each batch item within the list can specify these parameters instead.",
 			},
 			"batch_results": {
@@ -67,6 +68,7 @@ func RoleNameDataSource() *schema.Resource {
 			"value": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				Description: "The value in which to encode.",
 			},
 		},
@@ -22,12 +22,23 @@ const (
 	FieldLeaseRenewable = "lease_renewable"
 	FieldDepth          = "depth"
 	FieldDataJSON       = "data_json"
+	FieldMFAMethodID    = "mfa_method_id"
+	FieldMFAPasscode    = "mfa_passcode"
+
+	FieldIdentityTokenAudience = "identity_token_audience"
+	FieldIdentityTokenTTL      = "identity_token_ttl"
+	FieldRoleArn               = "role_arn"
+
+	FieldCreationLDIF = "creation_ldif"
+	FieldDeletionLDIF = "deletion_ldif"
+	FieldRollbackLDIF = "rollback_ldif"
 
 	/*
 		common environment variables
 	*/
 	EnvVarVaultNamespaceImport = "TERRAFORM_VAULT_NAMESPACE_IMPORT"
 	EnvVarSkipChildToken       = "TERRAFORM_VAULT_SKIP_CHILD_TOKEN"
+	EnvVarMFAPasscode          = "TERRAFORM_VAULT_MFA_PASSCODE"
 
 	/*
 		common mount types
@@ -38,6 +49,7 @@ const (
 	MountTypeKMIP     = "kmip"
 	MountTypeRabbitMQ = "rabbitmq"
 	MountTypeNomad    = "nomad"
+	MountTypeLDAP     = "ldap"
 
 	/*
 		misc. path related constants
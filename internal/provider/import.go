@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// ImportStatePassthroughNamespace is a schema.StateFunc that imports the
+// resource by its ID, like schema.ImportStatePassthrough. The resource's
+// namespace is set from the environment, so that namespaced resources
+// round trip on import.
+func ImportStatePassthroughNamespace(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+		log.Printf("[DEBUG] Setting %q from environment on import of %q", consts.FieldNamespace, d.Id())
+		if err := d.Set(consts.FieldNamespace, ns); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
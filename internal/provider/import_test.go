@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+func TestImportStatePassthroughNamespace(t *testing.T) {
+	tests := []struct {
+		name  string
+		envNS string
+		want  string
+	}{
+		{
+			name:  "with-env",
+			envNS: "ns1/ns2",
+			want:  "ns1/ns2",
+		},
+		{
+			name: "without-env",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(consts.EnvVarVaultNamespaceImport, tt.envNS)
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				consts.FieldNamespace: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			}, map[string]interface{}{})
+			d.SetId("foo")
+
+			got, err := ImportStatePassthroughNamespace(d, nil)
+			if err != nil {
+				t.Fatalf("ImportStatePassthroughNamespace() unexpected error %s", err)
+			}
+
+			if len(got) != 1 || got[0].Id() != "foo" {
+				t.Fatalf("ImportStatePassthroughNamespace() expected a single resource with ID %q, got %v", "foo", got)
+			}
+
+			if ns := got[0].Get(consts.FieldNamespace).(string); ns != tt.want {
+				t.Errorf("ImportStatePassthroughNamespace() expected namespace %q, got %q", tt.want, ns)
+			}
+		})
+	}
+}
@@ -1,21 +1,31 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/go-secure-stdlib/awsutil"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/command/config"
+	"github.com/oracle/oci-go-sdk/common"
+	ociauth "github.com/oracle/oci-go-sdk/common/auth"
 
 	"github.com/hashicorp/terraform-provider-vault/helper"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
@@ -30,6 +40,8 @@ type ProviderMeta struct {
 	resourceData *schema.ResourceData
 	clientCache  map[string]*api.Client
 	m            sync.RWMutex
+	// childTokenWatcher renews the child token, it is nil when no renewal is done.
+	childTokenWatcher *api.LifetimeWatcher
 }
 
 // GetClient returns the providers default Vault client.
@@ -88,8 +100,35 @@ func (p *ProviderMeta) validate() error {
 	return nil
 }
 
+// StopChildTokenRenewal stops the background renewal of the child token, if any.
+func (p *ProviderMeta) StopChildTokenRenewal() {
+	if p.childTokenWatcher != nil {
+		p.childTokenWatcher.Stop()
+	}
+}
+
+// NewProviderMetaContext sets up the Provider to service Vault requests.
+// It is meant to be used as a schema.ConfigureContextFunc, the child token
+// renewal is stopped once the provider is stopped.
+func NewProviderMetaContext(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	meta, err := NewProviderMeta(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	p := meta.(*ProviderMeta)
+	if stopCtx, ok := schema.StopContext(ctx); ok && p.childTokenWatcher != nil {
+		go func() {
+			<-stopCtx.Done()
+			log.Printf("[DEBUG] Provider stopped, stopping auto-renewal of the Vault child token")
+			p.StopChildTokenRenewal()
+		}()
+	}
+
+	return p, nil
+}
+
 // NewProviderMeta sets up the Provider to service Vault requests.
-// It is meant to be used as a schema.ConfigureFunc.
 func NewProviderMeta(d *schema.ResourceData) (interface{}, error) {
 	clientConfig := api.DefaultConfig()
 	addr := d.Get("address").(string)
@@ -110,15 +149,21 @@ func NewProviderMeta(d *schema.ResourceData) (interface{}, error) {
 		clientAuthKey = clientAuth["key_file"].(string)
 	}
 
-	err := clientConfig.ConfigureTLS(&api.TLSConfig{
+	tlsServerName := d.Get("tls_server_name").(string)
+	if err := validateTLSServerName(clientConfig.Address, tlsServerName); err != nil {
+		log.Printf("[WARN] %s, it will be ignored", err)
+	}
+
+	tlsConfig := api.TLSConfig{
 		CACert:        d.Get("ca_cert_file").(string),
 		CAPath:        d.Get("ca_cert_dir").(string),
 		Insecure:      d.Get("skip_tls_verify").(bool),
-		TLSServerName: d.Get("tls_server_name").(string),
+		TLSServerName: tlsServerName,
 
 		ClientCert: clientAuthCert,
 		ClientKey:  clientAuthKey,
-	})
+	}
+	err := clientConfig.ConfigureTLS(&tlsConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure TLS for Vault API: %s", err)
 	}
@@ -129,8 +174,9 @@ func NewProviderMeta(d *schema.ResourceData) (interface{}, error) {
 		helper.DefaultTransportOptions(),
 	)
 
-	// enable ReadYourWrites to support read-after-write on Vault Enterprise
-	clientConfig.ReadYourWrites = true
+	// enable ReadYourWrites to support read-after-write on Vault Enterprise,
+	// the last returned X-Vault-Index is sent along with every subsequent request.
+	clientConfig.ReadYourWrites = !d.Get("skip_read_your_writes").(bool)
 
 	// set default MaxRetries
 	clientConfig.MaxRetries = DefaultMaxHTTPRetries
@@ -172,40 +218,64 @@ func NewProviderMeta(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
-	// Attempt to use auth/<mount>login if 'auth_login' is provided in provider config
-	authLoginI := d.Get("auth_login").([]interface{})
-	if len(authLoginI) > 1 {
-		return "", fmt.Errorf("auth_login block may appear only once")
-	}
-
-	if len(authLoginI) == 1 {
-		authLogin := authLoginI[0].(map[string]interface{})
-		authLoginPath := authLogin[consts.FieldPath].(string)
-		authLoginNamespace := ""
-		if authLoginNamespaceI, ok := authLogin[consts.FieldNamespace]; ok {
-			authLoginNamespace = authLoginNamespaceI.(string)
-			client.SetNamespace(authLoginNamespace)
-		}
-		authLoginParameters := authLogin[consts.FieldParameters].(map[string]interface{})
-
-		method := authLogin[consts.FieldMethod].(string)
-		if method == "aws" {
-			logger := hclog.Default()
-			if logging.IsDebugOrHigher() {
-				logger.SetLevel(hclog.Debug)
-			} else {
-				logger.SetLevel(hclog.Error)
-			}
-			if err := signAWSLogin(authLoginParameters, logger); err != nil {
-				return nil, fmt.Errorf("error signing AWS login request: %s", err)
+	// Attempt to use auth/<mount>login if 'auth_login' is provided in provider config,
+	// each auth_login block is tried in order until one of them succeeds,
+	// followed by any of the method specific auth_login_* blocks.
+	var attempts []authLoginAttempt
+	for _, v := range d.Get("auth_login").([]interface{}) {
+		authLogin := v.(map[string]interface{})
+		attempts = append(attempts, authLoginAttempt{
+			path: authLogin[consts.FieldPath].(string),
+			login: func() (string, error) {
+				return authLoginWrite(client, authLogin)
+			},
+		})
+	}
+	if v, ok := d.GetOk("auth_login_cert"); ok {
+		certLogin := v.([]interface{})[0].(map[string]interface{})
+		attempts = append(attempts, authLoginAttempt{
+			path: authLoginCertPath(certLogin),
+			login: func() (string, error) {
+				return authLoginCertWrite(client, tlsConfig, certLogin)
+			},
+		})
+	}
+
+	if v, ok := d.GetOk("auth_login_oci"); ok {
+		ociLogin := v.([]interface{})[0].(map[string]interface{})
+		attempts = append(attempts, authLoginAttempt{
+			path: authLoginOCIPath(ociLogin),
+			login: func() (string, error) {
+				return authLoginOCIWrite(client, ociLogin)
+			},
+		})
+	}
+
+	if len(attempts) > 0 {
+		namespace := client.Namespace()
+
+		var errs error
+		var authLoginToken string
+		for _, attempt := range attempts {
+			t, err := attempt.login()
+			if err != nil {
+				log.Printf("[DEBUG] Login via %q failed: %s", attempt.path, err)
+				errs = multierror.Append(errs, fmt.Errorf("login via %q: %w", attempt.path, err))
+				continue
 			}
+			log.Printf("[DEBUG] Login via %q succeeded", attempt.path)
+			authLoginToken = t
+			break
 		}
 
-		secret, err := client.Logical().Write(authLoginPath, authLoginParameters)
-		if err != nil {
-			return nil, err
+		if authLoginToken != "" {
+			token = authLoginToken
+		} else if token != "" && d.Get("auth_login_fallback_to_token").(bool) {
+			log.Printf("[WARN] All auth_login attempts failed, falling back to the configured token: %s", errs)
+			client.SetNamespace(namespace)
+		} else {
+			return nil, errs
 		}
-		token = secret.Auth.ClientToken
 	}
 	if token != "" {
 		client.SetToken(token)
@@ -214,9 +284,23 @@ func NewProviderMeta(d *schema.ResourceData) (interface{}, error) {
 		return nil, errors.New("no vault token found")
 	}
 
+	var childTokenWatcher *api.LifetimeWatcher
 	skipChildToken := d.Get("skip_child_token").(bool)
 	if !skipChildToken {
-		err := setChildToken(d, client)
+		childToken, err := setChildToken(d, client)
+		if err != nil {
+			return nil, err
+		}
+
+		// clone the client now, since the child token belongs to the token's
+		// namespace rather than the requested namespace.
+		renewClient, err := client.Clone()
+		if err != nil {
+			return nil, err
+		}
+		renewClient.SetToken(client.Token())
+
+		childTokenWatcher, err = startChildTokenRenewal(renewClient, childToken)
 		if err != nil {
 			return nil, err
 		}
@@ -229,15 +313,326 @@ func NewProviderMeta(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	return &ProviderMeta{
-		resourceData: d,
-		client:       client,
+		resourceData:      d,
+		client:            client,
+		childTokenWatcher: childTokenWatcher,
 	}, nil
 }
 
+// authLoginAttempt is a single login, performed while configuring the provider.
+type authLoginAttempt struct {
+	path  string
+	login func() (string, error)
+}
+
+// authLoginWrite logs in with the auth_login block and returns the resulting token.
+func authLoginWrite(client *api.Client, authLogin map[string]interface{}) (string, error) {
+	authLoginPath := authLogin[consts.FieldPath].(string)
+	if authLoginNamespaceI, ok := authLogin[consts.FieldNamespace]; ok {
+		client.SetNamespace(authLoginNamespaceI.(string))
+	}
+	authLoginParameters := authLogin[consts.FieldParameters].(map[string]interface{})
+
+	method := authLogin[consts.FieldMethod].(string)
+	if method == "aws" {
+		logger := hclog.Default()
+		if logging.IsDebugOrHigher() {
+			logger.SetLevel(hclog.Debug)
+		} else {
+			logger.SetLevel(hclog.Error)
+		}
+		if err := signAWSLogin(authLoginParameters, logger); err != nil {
+			return "", fmt.Errorf("error signing AWS login request: %s", err)
+		}
+	}
+
+	mfaMethodID, _ := authLogin[consts.FieldMFAMethodID].(string)
+	mfaPasscode, _ := authLogin[consts.FieldMFAPasscode].(string)
+	secret, err := loginWithMFA(client, authLoginPath, authLoginParameters, mfaMethodID, mfaPasscode)
+	if err != nil {
+		return "", err
+	}
+
+	if secret.Auth.ClientToken == "" {
+		return "", fmt.Errorf("no token returned from %q", authLoginPath)
+	}
+
+	return secret.Auth.ClientToken, nil
+}
+
+func authLoginCertPath(certLogin map[string]interface{}) string {
+	return fmt.Sprintf("auth/%s/login", strings.Trim(certLogin[consts.FieldMount].(string), "/"))
+}
+
+// authLoginCertWrite logs in with the TLS certificate auth method, using a
+// dedicated client that presents the auth_login_cert block's certificate.
+func authLoginCertWrite(client *api.Client, tlsConfig api.TLSConfig, certLogin map[string]interface{}) (string, error) {
+	certFile, _ := certLogin["cert_file"].(string)
+	keyFile, _ := certLogin["key_file"].(string)
+	if certFile == "" || keyFile == "" {
+		return "", errors.New("both cert_file and key_file must be set")
+	}
+
+	if ns, ok := certLogin[consts.FieldNamespace]; ok {
+		client.SetNamespace(ns.(string))
+	}
+
+	tlsConfig.ClientCert = certFile
+	tlsConfig.ClientKey = keyFile
+
+	config := api.DefaultConfig()
+	config.Address = client.Address()
+	if err := config.ConfigureTLS(&tlsConfig); err != nil {
+		return "", fmt.Errorf("failed to configure TLS for certificate login: %s", err)
+	}
+
+	loginClient, err := api.NewClient(config)
+	if err != nil {
+		return "", err
+	}
+	loginClient.SetHeaders(client.Headers())
+	loginClient.ClearToken()
+
+	data := map[string]interface{}{}
+	if name, _ := certLogin[consts.FieldName].(string); name != "" {
+		data[consts.FieldName] = name
+	}
+
+	path := authLoginCertPath(certLogin)
+	secret, err := loginClient.Logical().Write(path, data)
+	if err != nil {
+		return "", err
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return "", fmt.Errorf("no token returned from %q", path)
+	}
+
+	return secret.Auth.ClientToken, nil
+}
+
+func authLoginOCIPath(ociLogin map[string]interface{}) string {
+	return fmt.Sprintf("auth/%s/login/%s",
+		strings.Trim(ociLogin[consts.FieldMount].(string), "/"), ociLogin["role"].(string))
+}
+
+// authLoginOCIWrite logs in with the OCI auth method, the login request is
+// authenticated by signing it with either the instance principal or the API
+// key of an OCI user.
+func authLoginOCIWrite(client *api.Client, ociLogin map[string]interface{}) (string, error) {
+	if ns, ok := ociLogin[consts.FieldNamespace]; ok {
+		client.SetNamespace(ns.(string))
+	}
+
+	var configProvider common.ConfigurationProvider
+	var err error
+	switch authType := ociLogin["auth_type"].(string); authType {
+	case ociAuthTypeInstance:
+		configProvider, err = ociauth.InstancePrincipalConfigurationProvider()
+	case ociAuthTypeAPIKey:
+		configFile, _ := ociLogin["config_file"].(string)
+		profile, _ := ociLogin["profile"].(string)
+		if configFile == "" && (profile == "" || profile == ociDefaultProfile) {
+			// also supports configuring the API key via the OCI SDK's environment variables.
+			configProvider = common.DefaultConfigProvider()
+		} else {
+			if configFile == "" {
+				configFile = ociDefaultConfigFile
+			}
+			configProvider, err = common.ConfigurationProviderFromFileWithProfile(configFile, profile, "")
+		}
+	default:
+		return "", fmt.Errorf("unsupported OCI auth_type %q", authType)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error configuring OCI credentials: %s", err)
+	}
+
+	path := authLoginOCIPath(ociLogin)
+	headers, err := signOCILogin(configProvider, client.Address(), path)
+	if err != nil {
+		return "", fmt.Errorf("error signing OCI login request: %s", err)
+	}
+
+	secret, err := client.Logical().Write(path, map[string]interface{}{
+		"request_headers": headers,
+	})
+	if err != nil {
+		return "", err
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return "", fmt.Errorf("no token returned from %q", path)
+	}
+
+	return secret.Auth.ClientToken, nil
+}
+
+// signOCILogin returns the headers of a signed GET request to the login path,
+// Vault verifies the signature using the (request-target) pseudo header.
+func signOCILogin(signer common.KeyProvider, address, path string) (http.Header, error) {
+	request, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(address, "/")+"/v1/"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+
+	if err := common.DefaultRequestSigner(signer).Sign(request); err != nil {
+		return nil, err
+	}
+
+	headers := request.Header
+	headers["(request-target)"] = []string{
+		fmt.Sprintf("%s %s", strings.ToLower(request.Method), request.URL.RequestURI()),
+	}
+
+	return headers, nil
+}
+
+// loginWithMFA writes the login request to path, satisfying any MFA
+// requirement with the passcode. When the MFA method is known the passcode is
+// sent along with the login request in the X-Vault-MFA header, otherwise the
+// login is validated with a follow-up request to sys/mfa/validate if Vault
+// responds with an MFA requirement.
+func loginWithMFA(client *api.Client, path string, parameters map[string]interface{}, methodID, passcode string) (*api.Secret, error) {
+	loginClient := client
+	if methodID != "" && passcode != "" {
+		c, err := client.Clone()
+		if err != nil {
+			return nil, err
+		}
+		c.SetMFACreds([]string{fmt.Sprintf("%s:%s", methodID, passcode)})
+		loginClient = c
+	}
+
+	secret, methodNames, err := mfaLoginWrite(loginClient, path, parameters)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil || secret.Auth == nil {
+		return nil, fmt.Errorf("no auth info returned from %q", path)
+	}
+
+	requirement := secret.Auth.MFARequirement
+	if requirement == nil {
+		return secret, nil
+	}
+
+	if passcode == "" {
+		return nil, fmt.Errorf("login via %q requires MFA, but no %s was provided", path, consts.FieldMFAPasscode)
+	}
+
+	// every constraint must be satisfied by one of its methods.
+	payload := make(map[string]interface{})
+	for name, constraint := range requirement.MFAConstraints {
+		var found bool
+		for _, m := range constraint.Any {
+			if methodID == "" || m.ID == methodID || methodNames[m.ID] == methodID {
+				payload[m.ID] = []string{passcode}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("MFA method %q does not satisfy the MFA constraint %q of login via %q",
+				methodID, name, path)
+		}
+	}
+
+	log.Printf("[DEBUG] Validating MFA for login via %q", path)
+	secret, err = client.Sys().MFAValidate(requirement.MFARequestID, payload)
+	if err != nil {
+		return nil, fmt.Errorf("error validating MFA for login via %q: %w", path, err)
+	}
+	if secret == nil || secret.Auth == nil {
+		return nil, fmt.Errorf("no auth info returned from MFA validation of login via %q", path)
+	}
+
+	return secret, nil
+}
+
+// mfaLoginResponse holds the names of the MFA methods in a login response,
+// they are not part of api.MFAMethodID.
+type mfaLoginResponse struct {
+	Auth *struct {
+		MFARequirement *struct {
+			MFAConstraints map[string]struct {
+				Any []struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"any"`
+			} `json:"mfa_constraints"`
+		} `json:"mfa_requirement"`
+	} `json:"auth"`
+}
+
+// mfaLoginWrite writes the login request to path. Along with the response it
+// returns the names of the MFA methods that may satisfy the MFA requirement,
+// keyed by their ID.
+func mfaLoginWrite(client *api.Client, path string, parameters map[string]interface{}) (*api.Secret, map[string]string, error) {
+	r := client.NewRequest(http.MethodPut, "/v1/"+path)
+	if err := r.SetJSONBody(parameters); err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret, err := api.ParseSecret(bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mfaResp mfaLoginResponse
+	if err := json.Unmarshal(body, &mfaResp); err != nil {
+		return nil, nil, err
+	}
+
+	methodNames := make(map[string]string)
+	if mfaResp.Auth != nil && mfaResp.Auth.MFARequirement != nil {
+		for _, constraint := range mfaResp.Auth.MFARequirement.MFAConstraints {
+			for _, m := range constraint.Any {
+				if m.Name != "" {
+					methodNames[m.ID] = m.Name
+				}
+			}
+		}
+	}
+
+	return secret, methodNames, nil
+}
+
+// validateTLSServerName checks that a TLS server name is only set when the
+// Vault address will be connected to over TLS.
+func validateTLSServerName(address, serverName string) error {
+	if serverName == "" {
+		return nil
+	}
+
+	u, err := url.Parse(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse Vault address %q: %w", address, err)
+	}
+
+	if u.Scheme != "https" {
+		return fmt.Errorf("tls_server_name %q requires an https Vault address, got %q", serverName, address)
+	}
+
+	return nil
+}
+
 // GetClient is meant to be called from a schema.Resource function.
 // It ensures that the returned api.Client's matches the resource's configured
 // namespace. The value for the namespace is resolved from *schema.ResourceData,
-// *schema.ResourceDiff, or *terraform.InstanceState.
+// *schema.ResourceDiff, or *terraform.InstanceState, see GetResourceNamespace.
 func GetClient(i interface{}, meta interface{}) (*api.Client, error) {
 	var p *ProviderMeta
 	switch v := meta.(type) {
@@ -247,6 +642,29 @@ func GetClient(i interface{}, meta interface{}) (*api.Client, error) {
 		return nil, fmt.Errorf("meta argument must be a %T, not %T", p, meta)
 	}
 
+	ns, err := GetResourceNamespace(i)
+	if err != nil {
+		return nil, err
+	}
+
+	if ns != "" {
+		return p.GetNSClient(ns)
+	}
+
+	return p.GetClient(), nil
+}
+
+// GetResourceNamespace returns the namespace of a resource, relative to the
+// provider's namespace. The namespace is resolved in the following order:
+//  1. the resource's namespace field
+//  2. the TERRAFORM_VAULT_NAMESPACE_IMPORT environment variable, which is
+//     only meant to be set when importing namespaced resources
+//
+// An empty namespace means that the resource belongs to the provider's
+// namespace, which is set from the provider's namespace field, the
+// VAULT_NAMESPACE environment variable, or the child token's namespace, in
+// that order.
+func GetResourceNamespace(i interface{}) (string, error) {
 	var ns string
 	switch v := i.(type) {
 	case *schema.ResourceData:
@@ -260,7 +678,7 @@ func GetClient(i interface{}, meta interface{}) (*api.Client, error) {
 	case *terraform.InstanceState:
 		ns = v.Attributes[consts.FieldNamespace]
 	default:
-		return nil, fmt.Errorf("GetClient() called with unsupported type %T", v)
+		return "", fmt.Errorf("GetResourceNamespace() called with unsupported type %T", v)
 	}
 
 	if ns == "" {
@@ -272,14 +690,10 @@ func GetClient(i interface{}, meta interface{}) (*api.Client, error) {
 		}
 	}
 
-	if ns != "" {
-		return p.GetNSClient(ns)
-	}
-
-	return p.GetClient(), nil
+	return strings.Trim(ns, "/"), nil
 }
 
-func setChildToken(d *schema.ResourceData, c *api.Client) error {
+func setChildToken(d *schema.ResourceData, c *api.Client) (*api.Secret, error) {
 	tokenName := d.Get("token_name").(string)
 	if tokenName == "" {
 		tokenName = "terraform"
@@ -302,7 +716,7 @@ func setChildToken(d *schema.ResourceData, c *api.Client) error {
 	// child token creation
 	tokenInfo, err := c.Auth().Token().LookupSelf()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if tokenNamespaceRaw, ok := tokenInfo.Data["namespace_path"]; ok {
 		tokenNamespace := tokenNamespaceRaw.(string)
@@ -311,15 +725,22 @@ func setChildToken(d *schema.ResourceData, c *api.Client) error {
 		}
 	}
 
-	renewable := false
+	ttl, maxTTL, err := childTokenTTLs(d.Get("max_lease_ttl_seconds").(int),
+		d.Get("token_ttl").(int), d.Get("token_max_ttl").(int))
+	if err != nil {
+		return nil, err
+	}
+
+	// the token can only be renewed when it is allowed to outlive its TTL.
+	renewable := maxTTL > ttl
 	childTokenLease, err := c.Auth().Token().Create(&api.TokenCreateRequest{
 		DisplayName:    tokenName,
-		TTL:            fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds").(int)),
-		ExplicitMaxTTL: fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds").(int)),
+		TTL:            fmt.Sprintf("%ds", ttl),
+		ExplicitMaxTTL: fmt.Sprintf("%ds", maxTTL),
 		Renewable:      &renewable,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create limited child token: %s", err)
+		return nil, fmt.Errorf("failed to create limited child token: %s", err)
 	}
 
 	childToken := childTokenLease.Auth.ClientToken
@@ -330,7 +751,65 @@ func setChildToken(d *schema.ResourceData, c *api.Client) error {
 	// Set the token to the generated child token
 	c.SetToken(childToken)
 
-	return nil
+	return childTokenLease, nil
+}
+
+// startChildTokenRenewal renews the child token in the background, at roughly
+// two thirds of its TTL, until it reaches its explicit max TTL or the returned
+// watcher is stopped. No renewal is done for non-renewable tokens.
+func startChildTokenRenewal(c *api.Client, secret *api.Secret) (*api.LifetimeWatcher, error) {
+	if secret == nil || secret.Auth == nil || !secret.Auth.Renewable {
+		log.Printf("[DEBUG] Vault child token is not renewable, skipping auto-renewal")
+		return nil, nil
+	}
+
+	watcher, err := c.NewLifetimeWatcher(&api.LifetimeWatcherInput{
+		Secret:    secret,
+		Increment: secret.Auth.LeaseDuration,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up auto-renewal of the child token: %w", err)
+	}
+
+	go watcher.Start()
+	go func() {
+		for {
+			select {
+			case err := <-watcher.DoneCh():
+				if err != nil {
+					log.Printf("[WARN] Stopped auto-renewal of the Vault child token: %s", err)
+				} else {
+					log.Printf("[INFO] Vault child token can no longer be renewed, stopped auto-renewal")
+				}
+				return
+			case r := <-watcher.RenewCh():
+				log.Printf("[DEBUG] Renewed the Vault child token at %s, TTL=%ds",
+					r.RenewedAt.Format(time.RFC3339), r.Secret.Auth.LeaseDuration)
+			}
+		}
+	}()
+
+	return watcher, nil
+}
+
+// childTokenTTLs returns the TTL and explicit max TTL of the child token.
+// Both default to maxLeaseTTL, when token_ttl and token_max_ttl are unset.
+func childTokenTTLs(maxLeaseTTL, tokenTTL, tokenMaxTTL int) (int, int, error) {
+	ttl := maxLeaseTTL
+	if tokenTTL > 0 {
+		ttl = tokenTTL
+	}
+
+	maxTTL := ttl
+	if tokenMaxTTL > 0 {
+		maxTTL = tokenMaxTTL
+	}
+
+	if maxTTL < ttl {
+		return 0, 0, fmt.Errorf("token_max_ttl (%d) must not be less than the child token's TTL (%d)", maxTTL, ttl)
+	}
+
+	return ttl, maxTTL, nil
 }
 
 func signAWSLogin(parameters map[string]interface{}, logger hclog.Logger) error {
@@ -410,3 +889,10 @@ func GetToken(d *schema.ResourceData) (string, error) {
 }
 
 const DefaultMaxHTTPRetries = 2
+
+const (
+	ociAuthTypeAPIKey    = "apikey"
+	ociAuthTypeInstance  = "instance"
+	ociDefaultProfile    = "DEFAULT"
+	ociDefaultConfigFile = "~/.oci/config"
+)
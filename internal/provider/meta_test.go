@@ -1,17 +1,33 @@
 package provider
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
 	vault_consts "github.com/hashicorp/vault/sdk/helper/consts"
+	"github.com/oracle/oci-go-sdk/common"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 )
@@ -288,7 +304,7 @@ func TestGetClient(t *testing.T) {
 				return nil
 			},
 			wantErr:   true,
-			expectErr: fmt.Errorf("GetClient() called with unsupported type <nil>"),
+			expectErr: fmt.Errorf("GetResourceNamespace() called with unsupported type <nil>"),
 		},
 		{
 			name:      "error-not-provider-meta",
@@ -345,3 +361,751 @@ func TestGetClient(t *testing.T) {
 		})
 	}
 }
+
+func TestGetResourceNamespace(t *testing.T) {
+	tests := []struct {
+		name      string
+		rscNS     string
+		envNS     string
+		want      string
+		wantErr   bool
+		expectErr error
+		ifaceFunc func(t *testing.T, ns string) interface{}
+	}{
+		{
+			name: "rsc-data-unset",
+			ifaceFunc: func(t *testing.T, ns string) interface{} {
+				return testNamespaceResourceData(t, ns)
+			},
+			want: "",
+		},
+		{
+			name:  "rsc-data",
+			rscNS: "ns1",
+			ifaceFunc: func(t *testing.T, ns string) interface{} {
+				return testNamespaceResourceData(t, ns)
+			},
+			want: "ns1",
+		},
+		{
+			name:  "rsc-data-trimmed",
+			rscNS: "/ns1/ns2/",
+			ifaceFunc: func(t *testing.T, ns string) interface{} {
+				return testNamespaceResourceData(t, ns)
+			},
+			want: "ns1/ns2",
+		},
+		{
+			name:  "rsc-data-import-env",
+			envNS: "ns1-import-env",
+			ifaceFunc: func(t *testing.T, ns string) interface{} {
+				return testNamespaceResourceData(t, ns)
+			},
+			want: "ns1-import-env",
+		},
+		{
+			name:  "rsc-data-over-import-env",
+			rscNS: "ns1",
+			envNS: "ns1-import-env",
+			ifaceFunc: func(t *testing.T, ns string) interface{} {
+				return testNamespaceResourceData(t, ns)
+			},
+			want: "ns1",
+		},
+		{
+			name:  "inst-state-import-env",
+			envNS: "ns1-import-env",
+			ifaceFunc: func(_ *testing.T, ns string) interface{} {
+				return &terraform.InstanceState{
+					Attributes: map[string]string{},
+				}
+			},
+			want: "ns1-import-env",
+		},
+		{
+			name:  "inst-state-over-import-env",
+			rscNS: "ns1",
+			envNS: "ns1-import-env",
+			ifaceFunc: func(_ *testing.T, ns string) interface{} {
+				return &terraform.InstanceState{
+					Attributes: map[string]string{
+						consts.FieldNamespace: ns,
+					},
+				}
+			},
+			want: "ns1",
+		},
+		{
+			name: "error-unsupported-type",
+			ifaceFunc: func(_ *testing.T, _ string) interface{} {
+				return nil
+			},
+			wantErr:   true,
+			expectErr: fmt.Errorf("GetResourceNamespace() called with unsupported type <nil>"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(consts.EnvVarVaultNamespaceImport, tt.envNS)
+
+			got, err := GetResourceNamespace(tt.ifaceFunc(t, tt.rscNS))
+			if tt.wantErr {
+				if !reflect.DeepEqual(err, tt.expectErr) {
+					t.Errorf("GetResourceNamespace() expected err %#v, actual %#v", tt.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetResourceNamespace() unexpected error %s", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("GetResourceNamespace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGetClient_namespacePrecedence asserts that the namespace of the
+// returned client is always relative to the provider's namespace.
+func TestGetClient_namespacePrecedence(t *testing.T) {
+	tests := []struct {
+		name       string
+		providerNS string
+		rscNS      string
+		envNS      string
+		want       string
+	}{
+		{
+			name: "root",
+			want: "",
+		},
+		{
+			name:       "provider",
+			providerNS: "ns1",
+			want:       "ns1",
+		},
+		{
+			name:  "resource",
+			rscNS: "ns2",
+			want:  "ns2",
+		},
+		{
+			name:       "provider-and-resource",
+			providerNS: "ns1",
+			rscNS:      "ns2",
+			want:       "ns1/ns2",
+		},
+		{
+			name:       "provider-and-import-env",
+			providerNS: "ns1",
+			envNS:      "ns3",
+			want:       "ns1/ns3",
+		},
+		{
+			name:       "resource-over-import-env",
+			providerNS: "ns1",
+			rscNS:      "ns2",
+			envNS:      "ns3",
+			want:       "ns1/ns2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(consts.EnvVarVaultNamespaceImport, tt.envNS)
+
+			client, err := api.NewClient(api.DefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+			// mirrors NewProviderMeta()
+			client.SetCloneHeaders(true)
+			if tt.providerNS != "" {
+				client.SetNamespace(tt.providerNS)
+			}
+
+			meta := &ProviderMeta{
+				client:       client,
+				resourceData: testNamespaceResourceData(t, tt.providerNS),
+			}
+
+			got, err := GetClient(testNamespaceResourceData(t, tt.rscNS), meta)
+			if err != nil {
+				t.Fatalf("GetClient() unexpected error %s", err)
+			}
+
+			actual := got.Headers().Get(vault_consts.NamespaceHeaderName)
+			if actual != tt.want {
+				t.Errorf("GetClient() got ns = %v, want %v", actual, tt.want)
+			}
+		})
+	}
+}
+
+func testNamespaceResourceData(t *testing.T, ns string) *schema.ResourceData {
+	t.Helper()
+
+	raw := map[string]interface{}{}
+	if ns != "" {
+		raw[consts.FieldNamespace] = ns
+	}
+
+	return schema.TestResourceDataRaw(t,
+		map[string]*schema.Schema{
+			consts.FieldNamespace: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		raw,
+	)
+}
+
+func Test_childTokenTTLs(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxLeaseTTL int
+		tokenTTL    int
+		tokenMaxTTL int
+		wantTTL     int
+		wantMaxTTL  int
+		wantErr     bool
+	}{
+		{
+			name:        "default",
+			maxLeaseTTL: 1200,
+			wantTTL:     1200,
+			wantMaxTTL:  1200,
+		},
+		{
+			name:        "token-ttl",
+			maxLeaseTTL: 1200,
+			tokenTTL:    3600,
+			wantTTL:     3600,
+			wantMaxTTL:  3600,
+		},
+		{
+			name:        "token-max-ttl",
+			maxLeaseTTL: 1200,
+			tokenMaxTTL: 7200,
+			wantTTL:     1200,
+			wantMaxTTL:  7200,
+		},
+		{
+			name:        "both",
+			maxLeaseTTL: 1200,
+			tokenTTL:    600,
+			tokenMaxTTL: 7200,
+			wantTTL:     600,
+			wantMaxTTL:  7200,
+		},
+		{
+			name:        "max-less-than-ttl",
+			maxLeaseTTL: 1200,
+			tokenTTL:    3600,
+			tokenMaxTTL: 1800,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ttl, maxTTL, err := childTokenTTLs(tt.maxLeaseTTL, tt.tokenTTL, tt.tokenMaxTTL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("childTokenTTLs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ttl != tt.wantTTL {
+				t.Errorf("childTokenTTLs() ttl = %v, want %v", ttl, tt.wantTTL)
+			}
+			if maxTTL != tt.wantMaxTTL {
+				t.Errorf("childTokenTTLs() maxTTL = %v, want %v", maxTTL, tt.wantMaxTTL)
+			}
+		})
+	}
+}
+
+func Test_startChildTokenRenewal(t *testing.T) {
+	tests := []struct {
+		name        string
+		secret      *api.Secret
+		wantWatcher bool
+	}{
+		{
+			name: "renewable",
+			secret: &api.Secret{
+				Auth: &api.SecretAuth{
+					ClientToken:   "token",
+					Renewable:     true,
+					LeaseDuration: 60,
+				},
+			},
+			wantWatcher: true,
+		},
+		{
+			name: "non-renewable",
+			secret: &api.Secret{
+				Auth: &api.SecretAuth{
+					ClientToken:   "token",
+					LeaseDuration: 60,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renewed := make(chan struct{}, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v1/auth/token/renew-self":
+					fmt.Fprint(w, `{"auth": {"client_token": "token", "renewable": true, "lease_duration": 60}}`)
+					select {
+					case renewed <- struct{}{}:
+					default:
+					}
+				default:
+					t.Errorf("unexpected request to %q", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			config := api.DefaultConfig()
+			config.Address = server.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			client.SetToken("token")
+
+			watcher, err := startChildTokenRenewal(client, tt.secret)
+			if err != nil {
+				t.Fatalf("startChildTokenRenewal() error = %v", err)
+			}
+
+			// stopping is a no-op when no renewal was started.
+			p := &ProviderMeta{childTokenWatcher: watcher}
+			defer p.StopChildTokenRenewal()
+
+			if !tt.wantWatcher {
+				if watcher != nil {
+					t.Fatalf("startChildTokenRenewal() expected no renewal for a non-renewable token")
+				}
+				return
+			}
+
+			if watcher == nil {
+				t.Fatalf("startChildTokenRenewal() expected renewal for a renewable token")
+			}
+
+			select {
+			case <-renewed:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("startChildTokenRenewal() timed out waiting for the token to be renewed")
+			}
+		})
+	}
+}
+
+func Test_validateTLSServerName(t *testing.T) {
+	tests := []struct {
+		name       string
+		address    string
+		serverName string
+		wantErr    bool
+	}{
+		{
+			name:    "no-server-name",
+			address: "http://127.0.0.1:8200",
+		},
+		{
+			name:       "https",
+			address:    "https://10.0.0.1:8200",
+			serverName: "vault.example.com",
+		},
+		{
+			name:       "http",
+			address:    "http://10.0.0.1:8200",
+			serverName: "vault.example.com",
+			wantErr:    true,
+		},
+		{
+			name:       "unix",
+			address:    "unix:///var/run/vault.sock",
+			serverName: "vault.example.com",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTLSServerName(tt.address, tt.serverName); (err != nil) != tt.wantErr {
+				t.Errorf("validateTLSServerName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewProviderMeta_readYourWrites(t *testing.T) {
+	defer func(v int) {
+		MaxHTTPRetriesCCC = v
+	}(MaxHTTPRetriesCCC)
+
+	tests := []struct {
+		name               string
+		skipReadYourWrites bool
+		want               bool
+	}{
+		{
+			name: "default",
+			want: true,
+		},
+		{
+			name:               "skip",
+			skipReadYourWrites: true,
+			want:               false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := testProviderResourceData(t, map[string]interface{}{
+				"address":               "http://127.0.0.1:8200",
+				"token":                 "token",
+				"skip_child_token":      true,
+				"skip_read_your_writes": tt.skipReadYourWrites,
+			})
+
+			meta, err := NewProviderMeta(d)
+			if err != nil {
+				t.Fatalf("NewProviderMeta() unexpected error %s", err)
+			}
+
+			got := meta.(*ProviderMeta).client.ReadYourWrites()
+			if got != tt.want {
+				t.Errorf("NewProviderMeta() got ReadYourWrites = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// testProviderResourceData returns the provider configuration read by
+// NewProviderMeta().
+func testProviderResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	s := map[string]*schema.Schema{
+		"max_retries": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"max_retries_ccc": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+	for _, k := range []string{
+		"address", "token", "add_address_to_env", "tls_server_name",
+		"ca_cert_file", "ca_cert_dir", consts.FieldNamespace,
+	} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+	}
+	for _, k := range []string{
+		"skip_tls_verify", "skip_read_your_writes", "skip_child_token",
+		"auth_login_fallback_to_token",
+	} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		}
+	}
+	for _, k := range []string{
+		"client_auth", "headers", "auth_login", "auth_login_cert", "auth_login_oci",
+	} {
+		s[k] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeMap,
+			},
+		}
+	}
+
+	return schema.TestResourceDataRaw(t, s, raw)
+}
+
+func Test_loginWithMFA(t *testing.T) {
+	const (
+		loginResponse = `{"auth": {"client_token": "token"}}`
+		mfaResponse   = `{"auth": {"mfa_requirement": {"mfa_request_id": "request-id", ` +
+			`"mfa_constraints": {"totp": {"any": [{"type": "totp", "id": "method-id", "uses_passcode": true}]}}}}}`
+		mfaNameResponse = `{"auth": {"mfa_requirement": {"mfa_request_id": "request-id", ` +
+			`"mfa_constraints": {"totp": {"any": [{"type": "totp", "id": "method-id", "name": "method-name", ` +
+			`"uses_passcode": true}]}}}}}`
+	)
+
+	tests := []struct {
+		name          string
+		methodID      string
+		passcode      string
+		loginResponse string
+		wantMFAHeader string
+		wantPayload   map[string]interface{}
+		wantErr       bool
+	}{
+		{
+			name:          "no-mfa",
+			loginResponse: loginResponse,
+		},
+		{
+			name:          "single-phase",
+			methodID:      "method-id",
+			passcode:      "123456",
+			loginResponse: loginResponse,
+			wantMFAHeader: "method-id:123456",
+		},
+		{
+			name:          "two-phase",
+			passcode:      "123456",
+			loginResponse: mfaResponse,
+			wantPayload: map[string]interface{}{
+				"method-id": []interface{}{"123456"},
+			},
+		},
+		{
+			name:          "two-phase-method-name",
+			methodID:      "method-name",
+			passcode:      "123456",
+			loginResponse: mfaNameResponse,
+			wantMFAHeader: "method-name:123456",
+			wantPayload: map[string]interface{}{
+				"method-id": []interface{}{"123456"},
+			},
+		},
+		{
+			name:          "two-phase-no-passcode",
+			loginResponse: mfaResponse,
+			wantErr:       true,
+		},
+		{
+			name:          "two-phase-unknown-method",
+			methodID:      "other",
+			loginResponse: mfaResponse,
+			passcode:      "123456",
+			wantMFAHeader: "other:123456",
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMFAHeader string
+			var gotPayload map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v1/auth/userpass/login/alice":
+					gotMFAHeader = r.Header.Get("X-Vault-MFA")
+					fmt.Fprint(w, tt.loginResponse)
+				case "/v1/sys/mfa/validate":
+					var body map[string]interface{}
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Fatal(err)
+					}
+					if body["mfa_request_id"] != "request-id" {
+						t.Errorf("unexpected mfa_request_id %#v", body["mfa_request_id"])
+					}
+					gotPayload, _ = body["mfa_payload"].(map[string]interface{})
+					fmt.Fprint(w, loginResponse)
+				default:
+					t.Errorf("unexpected request to %q", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			config := api.DefaultConfig()
+			config.Address = server.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			secret, err := loginWithMFA(client, "auth/userpass/login/alice",
+				map[string]interface{}{"password": "secret"}, tt.methodID, tt.passcode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loginWithMFA() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if gotMFAHeader != tt.wantMFAHeader {
+				t.Errorf("loginWithMFA() X-Vault-MFA = %q, want %q", gotMFAHeader, tt.wantMFAHeader)
+			}
+			if !reflect.DeepEqual(gotPayload, tt.wantPayload) {
+				t.Errorf("loginWithMFA() mfa_payload = %#v, want %#v", gotPayload, tt.wantPayload)
+			}
+
+			if tt.wantErr {
+				return
+			}
+			if secret.Auth.ClientToken != "token" {
+				t.Errorf("loginWithMFA() token = %q, want %q", secret.Auth.ClientToken, "token")
+			}
+		})
+	}
+}
+
+func Test_authLoginCertWrite(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := testWriteClientCert(t, dir)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/auth/cert/login" {
+			t.Errorf("unexpected request to %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if len(r.TLS.PeerCertificates) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errors": ["no client certificate"]}`)
+			return
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body["name"] != "web" {
+			t.Errorf("unexpected name %#v", body["name"])
+		}
+		fmt.Fprint(w, `{"auth": {"client_token": "cert-token"}}`)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		certLogin map[string]interface{}
+		wantToken string
+		wantErr   bool
+	}{
+		{
+			name: "basic",
+			certLogin: map[string]interface{}{
+				consts.FieldMount: "cert",
+				consts.FieldName:  "web",
+				"cert_file":       certFile,
+				"key_file":        keyFile,
+			},
+			wantToken: "cert-token",
+		},
+		{
+			name: "no-key",
+			certLogin: map[string]interface{}{
+				consts.FieldMount: "cert",
+				consts.FieldName:  "web",
+				"cert_file":       certFile,
+				"key_file":        "",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := api.DefaultConfig()
+			config.Address = server.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			token, err := authLoginCertWrite(client, api.TLSConfig{CACert: caFile}, tt.certLogin)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("authLoginCertWrite() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if token != tt.wantToken {
+				t.Errorf("authLoginCertWrite() token = %q, want %q", token, tt.wantToken)
+			}
+		})
+	}
+}
+
+func testWriteClientCert(t *testing.T, dir string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return certFile, keyFile
+}
+
+func Test_signOCILogin(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	signer := common.NewRawConfigurationProvider(
+		"ocid1.tenancy.oc1..tenancy", "ocid1.user.oc1..user", "us-ashburn-1",
+		"aa:bb:cc", string(keyPEM), nil)
+
+	headers, err := signOCILogin(signer, "https://vault.example.com:8200/", "auth/oci/login/dev-role")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := headers.Get("(request-target)"), "get /v1/auth/oci/login/dev-role"; got != want {
+		t.Errorf("signOCILogin() (request-target) = %q, want %q", got, want)
+	}
+	if headers.Get("Date") == "" {
+		t.Errorf("signOCILogin() Date header not set")
+	}
+
+	authorization := headers.Get("Authorization")
+	for _, want := range []string{
+		`keyId="ocid1.tenancy.oc1..tenancy/ocid1.user.oc1..user/aa:bb:cc"`,
+		`headers="date (request-target) host"`,
+		`algorithm="rsa-sha256"`,
+	} {
+		if !strings.Contains(authorization, want) {
+			t.Errorf("signOCILogin() Authorization = %q, want it to contain %q", authorization, want)
+		}
+	}
+}
@@ -351,3 +351,70 @@ func GetAPIRequestData(d *schema.ResourceData, fieldMap map[string]string) map[s
 
 	return data
 }
+
+const (
+	// RemountTimeout is the maximum amount of time to wait for a mount
+	// migration to complete.
+	RemountTimeout = 5 * time.Minute
+
+	remountStatusSuccess = "success"
+	remountStatusFailure = "failure"
+)
+
+// Remount moves the mount at from to the path to, preserving its data.
+// Starting with Vault 1.10 mount migrations are asynchronous, in which case the
+// migration's status is polled until it either succeeds, fails or the timeout
+// is reached. Older versions of Vault complete the remount synchronously and
+// return no migration ID.
+func Remount(client *api.Client, from, to string, timeout time.Duration) error {
+	from, to = TrimSlashes(from), TrimSlashes(to)
+
+	log.Printf("[DEBUG] Remounting %q to %q", from, to)
+	resp, err := client.Logical().Write("sys/remount", map[string]interface{}{
+		"from": from,
+		"to":   to,
+	})
+	if err != nil {
+		return fmt.Errorf("error remounting %q to %q: %w", from, to, err)
+	}
+
+	var migrationID string
+	if resp != nil {
+		migrationID, _ = resp.Data["migration_id"].(string)
+	}
+	if migrationID == "" {
+		log.Printf("[DEBUG] Remounted %q to %q", from, to)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	for {
+		status, err := client.Sys().RemountStatusWithContext(ctx, migrationID)
+		if err != nil {
+			return fmt.Errorf("error reading status of migration %q from %q to %q: %w",
+				migrationID, from, to, err)
+		}
+
+		var s string
+		if status.MigrationInfo != nil {
+			s = status.MigrationInfo.MigrationStatus
+		}
+		log.Printf("[DEBUG] Status of migration %q from %q to %q is %q", migrationID, from, to, s)
+
+		switch s {
+		case remountStatusSuccess:
+			return nil
+		case remountStatusFailure:
+			return fmt.Errorf("migration %q from %q to %q failed", migrationID, from, to)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("migration %q from %q to %q did not complete within %s",
+				migrationID, from, to, timeout)
+		case <-time.After(time.Second):
+		}
+	}
+}
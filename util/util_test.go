@@ -1,11 +1,16 @@
 package util
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 )
 
 type testingStruct struct {
@@ -365,3 +370,73 @@ func TestGetAPIRequestData(t *testing.T) {
 		})
 	}
 }
+
+func TestRemount(t *testing.T) {
+	tests := []struct {
+		name     string
+		remount  string
+		statuses []string
+		wantErr  bool
+	}{
+		{
+			name:    "synchronous",
+			remount: "",
+		},
+		{
+			name:     "success",
+			remount:  `{"data": {"migration_id": "abc"}}`,
+			statuses: []string{"in-progress", "success"},
+		},
+		{
+			name:     "failure",
+			remount:  `{"data": {"migration_id": "abc"}}`,
+			statuses: []string{"in-progress", "failure"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v1/sys/remount":
+					var body map[string]string
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Fatal(err)
+					}
+					if body["from"] != "foo" || body["to"] != "bar" {
+						t.Errorf("unexpected remount request %#v", body)
+					}
+					if tt.remount == "" {
+						w.WriteHeader(http.StatusNoContent)
+						return
+					}
+					fmt.Fprint(w, tt.remount)
+				case "/v1/sys/remount/status/abc":
+					fmt.Fprintf(w, `{"data": {"migration_id": "abc", "migration_info": {"status": %q}}}`,
+						tt.statuses[polls])
+					polls++
+				default:
+					t.Errorf("unexpected request to %q", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			config := api.DefaultConfig()
+			config.Address = server.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = Remount(client, "foo/", "/bar", time.Minute)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Remount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if polls != len(tt.statuses) {
+				t.Errorf("Remount() polled status %d times, want %d", polls, len(tt.statuses))
+			}
+		})
+	}
+}
@@ -65,7 +65,7 @@ func authMountTuneSchema() *schema.Schema {
 					Type:         schema.TypeString,
 					Optional:     true,
 					Description:  "Specifies the type of tokens that should be returned by the mount.",
-					ValidateFunc: validation.StringInSlice([]string{"default-service", "default-batch", "service", "batch"}, false),
+					ValidateFunc: validation.StringInSlice(mountTokenTypes, false),
 				},
 			},
 		},
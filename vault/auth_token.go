@@ -5,8 +5,8 @@ import (
 	"net"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -34,6 +34,15 @@ var commonTokenFields = []string{
 	TokenFieldNumUses,
 }
 
+var (
+	// authTokenTypes are the token types accepted by auth backend roles.
+	authTokenTypes = []string{"service", "batch", "default"}
+	// mountTokenTypes are the token types accepted when tuning an auth mount.
+	mountTokenTypes = []string{"default-service", "default-batch", "service", "batch"}
+	// tokenStoreTokenTypes are the token types accepted by token store roles.
+	tokenStoreTokenTypes = append(append([]string{}, authTokenTypes...), "default-service", "default-batch")
+)
+
 type addTokenFieldsConfig struct {
 	TokenBoundCIDRsConflict     []string
 	TokenExplicitMaxTTLConflict []string
@@ -44,6 +53,8 @@ type addTokenFieldsConfig struct {
 	TokenTTLConflict            []string
 
 	TokenTypeDefault string
+	// TokenTypes are the accepted values of token_type, defaults to authTokenTypes.
+	TokenTypes []string
 }
 
 // Common field schemas for Auth Backends
@@ -51,11 +62,15 @@ func addTokenFields(fields map[string]*schema.Schema, config *addTokenFieldsConf
 	if config.TokenTypeDefault == "" {
 		config.TokenTypeDefault = "default"
 	}
+	if len(config.TokenTypes) == 0 {
+		config.TokenTypes = authTokenTypes
+	}
 
 	fields[TokenFieldBoundCIDRs] = &schema.Schema{
 		Type: schema.TypeSet,
 		Elem: &schema.Schema{
-			Type: schema.TypeString,
+			Type:         schema.TypeString,
+			ValidateFunc: validateCIDR,
 		},
 		Description: "Specifies the blocks of IP addresses which are allowed to use the generated token",
 		Optional:    true,
@@ -99,10 +114,11 @@ func addTokenFields(fields map[string]*schema.Schema, config *addTokenFieldsConf
 	}
 
 	fields[TokenFieldType] = &schema.Schema{
-		Type:        schema.TypeString,
-		Description: "The type of token to generate, service or batch",
-		Optional:    true,
-		Default:     config.TokenTypeDefault,
+		Type:         schema.TypeString,
+		Description:  "The type of token to generate, one of " + strings.Join(config.TokenTypes, ", "),
+		Optional:     true,
+		Default:      config.TokenTypeDefault,
+		ValidateFunc: validation.StringInSlice(config.TokenTypes, false),
 	}
 
 	fields[TokenFieldTTL] = &schema.Schema{
@@ -320,40 +336,47 @@ func handleCIDRField(d *schema.ResourceData, k string, resp *api.Secret) (interf
 	}
 
 	var addrs []string
-
 	if v, ok := resp.Data[k]; ok && v != nil {
 		for _, val := range v.([]interface{}) {
-			// Vault strips the CIDR prefix from IPv4 /32, IPv6 /128 host addresses
-			// we want to normalize these addresses unless they are otherwise explicitly
-			// configured in the Terraform config.
-			addr := val.(string)
-			if s != nil && s.Contains(val) {
-				addrs = append(addrs, addr)
-				continue
+			addrs = append(addrs, val.(string))
+		}
+	}
+
+	return normalizeCIDRs(s, addrs)
+}
+
+// normalizeCIDRs restores the CIDR prefix that Vault strips from IPv4 /32 and
+// IPv6 /128 host addresses, unless the address is explicitly configured in s
+// without a prefix.
+func normalizeCIDRs(s *schema.Set, addrs []string) ([]string, error) {
+	var result []string
+	for _, addr := range addrs {
+		if s != nil && s.Contains(addr) {
+			result = append(result, addr)
+			continue
+		}
+
+		if _, _, err := net.ParseCIDR(addr); err != nil {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				// should never happen
+				return nil, fmt.Errorf("invalid address %q in response", addr)
 			}
 
-			if _, _, err := net.ParseCIDR(addr); err != nil {
-				ip := net.ParseIP(addr)
-				if ip == nil {
-					// should never happen
-					return nil, fmt.Errorf("invalid address %q in response", addr)
-				}
-
-				var bits int
-				if m := ip.DefaultMask(); m != nil {
-					// IPv4
-					_, bits = m.Size()
-				} else {
-					// IPv6
-					bits = 128
-				}
-				addr = fmt.Sprintf("%s/%d", addr, bits)
+			var bits int
+			if m := ip.DefaultMask(); m != nil {
+				// IPv4
+				_, bits = m.Size()
+			} else {
+				// IPv6
+				bits = 128
 			}
-			addrs = append(addrs, addr)
+			addr = fmt.Sprintf("%s/%d", addr, bits)
 		}
+		result = append(result, addr)
 	}
 
-	return addrs, nil
+	return result, nil
 }
 
 func getCommonTokenFieldMap(resp *api.Secret) map[string]interface{} {
@@ -363,39 +386,3 @@ func getCommonTokenFieldMap(resp *api.Secret) map[string]interface{} {
 	}
 	return m
 }
-
-func checkCIDRs(d *schema.ResourceData, k string) diag.Diagnostics {
-	diags := diag.Diagnostics{}
-
-	var cidrs []interface{}
-	if i, ok := d.GetOk(k); ok {
-		switch t := i.(type) {
-		case *schema.Set:
-			cidrs = t.List()
-		case []interface{}:
-			cidrs = t
-		default:
-			return diag.Errorf("could not check CIDRs, unsupported type %T", t)
-		}
-
-		var invalid []string
-		for _, v := range cidrs {
-			addr := v.(string)
-			if _, _, err := net.ParseCIDR(addr); err != nil {
-				invalid = append(invalid, addr)
-			}
-		}
-
-		count := len(invalid)
-		if count > 0 {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary: fmt.Sprintf("found %d invalid CIDR block(s) for %q, values=%s", count, k,
-					strings.Join(invalid, ",")),
-				Detail: `Please ensure all values are configured in CIDR notation. 
-This check will be enforced in future releases of the provider, and will result in an error`,
-			})
-		}
-	}
-	return diags
-}
@@ -94,3 +94,57 @@ func Test_handleCIDRField(t *testing.T) {
 		})
 	}
 }
+
+func Test_addTokenFields_tokenType(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *addTokenFieldsConfig
+		tokenType string
+		wantErr   bool
+	}{
+		{
+			name:      "auth-role-batch",
+			config:    &addTokenFieldsConfig{},
+			tokenType: "batch",
+		},
+		{
+			name:      "auth-role-default",
+			config:    &addTokenFieldsConfig{},
+			tokenType: "default",
+		},
+		{
+			name:      "auth-role-default-batch",
+			config:    &addTokenFieldsConfig{},
+			tokenType: "default-batch",
+			wantErr:   true,
+		},
+		{
+			name:      "auth-role-invalid",
+			config:    &addTokenFieldsConfig{},
+			tokenType: "foo",
+			wantErr:   true,
+		},
+		{
+			name:      "token-role-default-batch",
+			config:    tokenAuthBackendRoleTokenConfig(),
+			tokenType: "default-batch",
+		},
+		{
+			name:      "token-role-invalid",
+			config:    tokenAuthBackendRoleTokenConfig(),
+			tokenType: "foo",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := map[string]*schema.Schema{}
+			addTokenFields(fields, tt.config)
+
+			_, errs := fields[TokenFieldType].ValidateFunc(tt.tokenType, TokenFieldType)
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("addTokenFields() token_type %q, errs = %v, wantErr %v", tt.tokenType, errs, tt.wantErr)
+			}
+		})
+	}
+}
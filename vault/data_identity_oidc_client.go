@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var identityOIDCClientDataSourceFields = []string{
+	"key",
+	"redirect_uris",
+	"assignments",
+	"id_token_ttl",
+	"access_token_ttl",
+	"client_id",
+	"client_secret",
+	"client_type",
+}
+
+func identityOIDCClientDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readOIDCClientDataSource,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the client.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the key used to sign ID tokens.",
+			},
+			"redirect_uris": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Redirection URI values used by the client.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"assignments": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The names of the assignments that determine which entities and groups can use the client.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"id_token_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time-to-live for ID tokens obtained by the client in seconds.",
+			},
+			"access_token_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time-to-live for access tokens obtained by the client in seconds.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Client ID from Vault.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The Client Secret from Vault, only set for confidential clients.",
+			},
+			"client_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The client type, either confidential or public.",
+			},
+		},
+	}
+}
+
+func readOIDCClientDataSource(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+	name := d.Get("name").(string)
+	path := getOIDCClientPath(name)
+
+	log.Printf("[DEBUG] Reading OIDC Client for %s", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading OIDC Client for %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read OIDC Client for %s", path)
+
+	if resp == nil {
+		return fmt.Errorf("no client found at %q", path)
+	}
+
+	d.SetId(path)
+
+	for _, k := range identityOIDCClientDataSourceFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on OIDC Client %q, err=%w", k, path, err)
+		}
+	}
+
+	return nil
+}
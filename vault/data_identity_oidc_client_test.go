@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceIdentityOIDCClient(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-client")
+	dataSourceName := "data.vault_identity_oidc_client.client"
+	resourceName := "vault_identity_oidc_client.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceIdentityOIDCClient_config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_id", resourceName, "client_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_secret", resourceName, "client_secret"),
+					resource.TestCheckResourceAttr(dataSourceName, "client_type", "confidential"),
+					resource.TestCheckResourceAttr(dataSourceName, "key", name),
+					resource.TestCheckResourceAttr(dataSourceName, "redirect_uris.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "assignments.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "assignments.0", "allow_all"),
+					resource.TestCheckResourceAttr(dataSourceName, "id_token_ttl", "2400"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_token_ttl", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceIdentityOIDCClient_config(name string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name               = "%[1]s"
+  allowed_client_ids = ["*"]
+  rotation_period    = 3600
+  verification_ttl   = 3600
+}
+
+resource "vault_identity_oidc_client" "test" {
+  name          = "%[1]s"
+  key           = vault_identity_oidc_key.key.name
+  redirect_uris = [
+    "http://127.0.0.1:8251/callback",
+    "http://127.0.0.1:8080/callback"
+  ]
+  assignments      = ["allow_all"]
+  id_token_ttl     = 2400
+  access_token_ttl = 7200
+}
+
+data "vault_identity_oidc_client" "client" {
+  name = vault_identity_oidc_client.test.name
+}`, name)
+}
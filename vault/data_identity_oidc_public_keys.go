@@ -11,20 +11,24 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
-const identityOIDCPublicKeysPathSuffix = "/.well-known/keys"
+const (
+	identityOIDCPublicKeysPathSuffix = "/.well-known/keys"
+	identityOIDCTokenPublicKeysPath  = "identity/oidc" + identityOIDCPublicKeysPathSuffix
+)
 
 func identityOIDCPublicKeysDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readOIDCPublicKeysResource,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the provider.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The name of the provider. If not set, the public keys " +
+					"used to sign Vault identity tokens are returned.",
 			},
 			"keys": {
 				Type: schema.TypeList,
-				Description: "The public portion of keys for an OIDC provider. " +
+				Description: "The public portion of keys for an OIDC provider, or for Vault identity tokens. " +
 					"Clients can use them to validate the authenticity of an identity token.",
 				Computed: true,
 				Elem: &schema.Schema{
@@ -40,8 +44,10 @@ func readOIDCPublicKeysResource(d *schema.ResourceData, meta interface{}) error
 	if e != nil {
 		return e
 	}
-	name := d.Get("name").(string)
-	path := "/v1/" + getOIDCProviderPath(name) + identityOIDCPublicKeysPathSuffix
+	path := "/v1/" + identityOIDCTokenPublicKeysPath
+	if name, ok := d.GetOk("name"); ok {
+		path = "/v1/" + getOIDCProviderPath(name.(string)) + identityOIDCPublicKeysPathSuffix
+	}
 	r := client.NewRequest("GET", path)
 
 	resp, err := client.RawRequest(r)
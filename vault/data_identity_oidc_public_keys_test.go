@@ -30,6 +30,44 @@ func TestDataSourceIdentityOIDCPublicKeys(t *testing.T) {
 	})
 }
 
+func TestDataSourceIdentityOIDCPublicKeys_identityTokens(t *testing.T) {
+	keyName := acctest.RandomWithPrefix("test-key")
+	roleName := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceIdentityOIDCPublicKeys_identityTokensConfig(keyName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_identity_oidc_public_keys.public", "id", "/v1/"+identityOIDCTokenPublicKeysPath),
+					resource.TestCheckResourceAttrSet("data.vault_identity_oidc_public_keys.public", "keys.0.kid"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceIdentityOIDCPublicKeys_identityTokensConfig(keyName, roleName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "test" {
+  name               = "%s"
+  allowed_client_ids = ["*"]
+  rotation_period    = 3600
+  verification_ttl   = 3600
+}
+
+resource "vault_identity_oidc_role" "test" {
+  name = "%s"
+  key  = vault_identity_oidc_key.test.name
+}
+
+data "vault_identity_oidc_public_keys" "public" {
+  depends_on = [vault_identity_oidc_role.test]
+}`, keyName, roleName)
+}
+
 func testDataSourceIdentityOIDCPublicKeys_config(keyName, clientName, providerName string) string {
 	return fmt.Sprintf(`
 resource "vault_identity_oidc_key" "test" {
@@ -24,6 +24,7 @@ func approleAuthBackendRoleIDDataSource() *schema.Resource {
 			"role_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The RoleID of the role.",
 			},
 			"backend": {
@@ -57,11 +58,13 @@ func approleAuthBackendRoleIDRead(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Read AppRole auth backend role %q RoleID", path)
 
 	if resp == nil {
-		d.SetId("")
-		return nil
+		return fmt.Errorf("AppRole auth backend role %q not found", path)
 	}
+
 	d.SetId(path + "/role-id")
-	d.Set("role_id", resp.Data["role_id"])
+	if err := d.Set("role_id", resp.Data["role_id"]); err != nil {
+		return err
+	}
 
 	return nil
 }
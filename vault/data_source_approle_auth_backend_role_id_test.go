@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -81,6 +82,22 @@ func TestAccAppRoleAuthBackendRoleID_customID(t *testing.T) {
 	})
 }
 
+func TestAccAppRoleAuthBackendRoleID_missingRole(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAppRoleAuthBackendRoleIDConfig_missingRole(backend, role),
+				ExpectError: regexp.MustCompile(`AppRole auth backend role ".+" not found`),
+			},
+		},
+	})
+}
+
 func testAccAppRoleAuthBackendRoleIDConfig_basic(backend, role string) string {
 	return fmt.Sprintf(`
 %s
@@ -100,3 +117,16 @@ data "vault_approle_auth_backend_role_id" "role" {
   role_name = "%s"
 }`, testAccAppRoleAuthBackendRoleConfig_full(backend, role, roleID), backend, role)
 }
+
+func testAccAppRoleAuthBackendRoleIDConfig_missingRole(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+data "vault_approle_auth_backend_role_id" "role" {
+  backend = vault_auth_backend.approle.path
+  role_name = "%s"
+}`, backend, role)
+}
@@ -63,7 +63,7 @@ func authBackendDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 		return e
 	}
 
-	targetPath := d.Get("path").(string)
+	targetPath := strings.Trim(d.Get("path").(string), "/")
 
 	auths, err := client.Sys().ListAuth()
 	if err != nil {
@@ -87,5 +87,5 @@ func authBackendDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// If we fell out here then we didn't find our Auth in the list.
-	return nil
+	return fmt.Errorf("no auth backend found at path %q", targetPath)
 }
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -25,6 +26,10 @@ func TestDataSourceAuthBackend(t *testing.T) {
 				Config: testDataSourceAuthBackend_config(path),
 				Check:  testDataSourceAuthBackend_check,
 			},
+			{
+				Config:      testDataSourceAuthBackendMissing_config(path),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`no auth backend found at path "%s-missing"`, path)),
+			},
 		},
 	})
 }
@@ -54,6 +59,14 @@ data "vault_auth_backend" "test" {
 `, path)
 }
 
+func testDataSourceAuthBackendMissing_config(path string) string {
+	return fmt.Sprintf(`
+data "vault_auth_backend" "test" {
+	path = "%s-missing"
+}
+`, path)
+}
+
 func testDataSourceAuthBackend_check(s *terraform.State) error {
 	baseResourceState := s.Modules[0].Resources["vault_auth_backend.test"]
 	if baseResourceState == nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -132,6 +133,17 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 		return e
 	}
 
+	secret, err := readAWSAccessCredentials(client, d)
+	if err != nil {
+		return err
+	}
+
+	return validateAWSAccessCredentials(secret, d.Get("type").(string), d.Get("region").(string))
+}
+
+// readAWSAccessCredentials requests a new set of credentials from the AWS
+// secret backend and sets them, along with the lease details, on d.
+func readAWSAccessCredentials(client *api.Client, d *schema.ResourceData) (*api.Secret, error) {
 	backend := d.Get("backend").(string)
 	credType := d.Get("type").(string)
 	role := d.Get("role").(string)
@@ -150,19 +162,12 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	log.Printf("[DEBUG] Reading %q from Vault with data %#v", path, data)
 	secret, err := client.Logical().ReadWithData(path, data)
 	if err != nil {
-		return fmt.Errorf("error reading from Vault: %s", err)
+		return nil, fmt.Errorf("error reading from Vault: %s", err)
 	}
 	log.Printf("[DEBUG] Read %q from Vault", path)
 
 	if secret == nil {
-		return fmt.Errorf("no role found at path %q", path)
-	}
-
-	accessKey := secret.Data["access_key"].(string)
-	secretKey := secret.Data["secret_key"].(string)
-	var securityToken string
-	if secret.Data["security_token"] != nil {
-		securityToken = secret.Data["security_token"].(string)
+		return nil, fmt.Errorf("no role found at path %q", path)
 	}
 
 	d.SetId(secret.LeaseID)
@@ -174,12 +179,24 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
 	d.Set(consts.FieldLeaseRenewable, secret.Renewable)
 
+	return secret, nil
+}
+
+// validateAWSAccessCredentials checks that the credentials in secret are
+// usable, waiting for them to propagate within AWS when necessary.
+func validateAWSAccessCredentials(secret *api.Secret, credType, region string) error {
+	accessKey := secret.Data["access_key"].(string)
+	secretKey := secret.Data["secret_key"].(string)
+	var securityToken string
+	if secret.Data["security_token"] != nil {
+		securityToken = secret.Data["security_token"].(string)
+	}
+
 	awsConfig := &aws.Config{
 		Credentials: credentials.NewStaticCredentials(accessKey, secretKey, securityToken),
 		HTTPClient:  cleanhttp.DefaultClient(),
 	}
 
-	region := d.Get("region").(string)
 	if region != "" {
 		awsConfig.Region = &region
 	}
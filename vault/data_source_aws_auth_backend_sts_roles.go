@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func awsAuthBackendSTSRolesDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: awsAuthBackendSTSRolesDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "aws",
+				Description: "Unique name of the auth backend to list the STS roles of.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"account_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The AWS account IDs that have an STS role configured.",
+			},
+		},
+	}
+}
+
+func awsAuthBackendSTSRolesDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := "auth/" + strings.Trim(d.Get("backend").(string), "/") + "/config/sts"
+
+	log.Printf("[DEBUG] Listing STS roles from %q", path)
+	resp, err := client.Logical().List(path)
+	if err != nil {
+		return diag.Errorf("error listing STS roles from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Listed STS roles from %q", path)
+
+	accountIDs := []interface{}{}
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			accountIDs = keys
+		}
+	}
+
+	if err := d.Set("account_ids", accountIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceAWSAuthBackendSTSRoles(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	accountID := strconv.Itoa(acctest.RandInt())
+	arn := acctest.RandomWithPrefix("arn:aws:iam::" + accountID + ":role/test-role")
+
+	datasourceName := "data.vault_aws_auth_backend_sts_roles.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceAWSAuthBackendSTSRolesConfig(backend, accountID, arn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "backend", backend),
+					resource.TestCheckResourceAttr(datasourceName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "account_ids.0", accountID),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceAWSAuthBackendSTSRolesConfig(backend, accountID, stsRole string) string {
+	return fmt.Sprintf(`
+%s
+
+data "vault_aws_auth_backend_sts_roles" "test" {
+  backend    = vault_auth_backend.aws.path
+  depends_on = [vault_aws_auth_backend_sts_role.role]
+}
+`, testAccAWSAuthBackendSTSRoleConfig_basic(backend, accountID, stsRole))
+}
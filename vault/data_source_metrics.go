@@ -0,0 +1,174 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	metricsFormatPrometheus = "prometheus"
+	metricsFormatJSON       = "json"
+)
+
+func metricsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: metricsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      metricsFormatPrometheus,
+				Description:  "The format of the metrics, either 'prometheus' or 'json'.",
+				ValidateFunc: validation.StringInSlice([]string{metricsFormatPrometheus, metricsFormatJSON}, false),
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "False if the telemetry for the requested format is not enabled on the Vault server.",
+			},
+			"metrics": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The raw metrics body, in the requested format.",
+			},
+			"gauges": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The gauge samples of the metrics, keyed by their name and labels. Only set for the 'prometheus' format.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func metricsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	format := d.Get("format").(string)
+	path := "sys/metrics"
+
+	log.Printf("[DEBUG] Reading %q metrics from %q", format, path)
+	req := client.NewRequest(http.MethodGet, "/v1/"+path)
+	req.Params.Set("format", format)
+	resp, err := client.RawRequestWithContext(ctx, req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+
+	enabled := true
+	var body []byte
+	if err != nil {
+		if !isMetricsNotEnabledErr(err) {
+			return diag.Errorf("error reading %q metrics from %q: %s", format, path, err)
+		}
+		log.Printf("[WARN] Telemetry for %q metrics is not enabled: %s", format, err)
+		enabled = false
+	} else {
+		body, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return diag.Errorf("error reading %q metrics from %q: %s", format, path, err)
+		}
+	}
+	log.Printf("[DEBUG] Read %q metrics from %q", format, path)
+
+	var gauges map[string]string
+	if enabled && format == metricsFormatPrometheus {
+		gauges = parsePrometheusGauges(string(body))
+	}
+
+	data := map[string]interface{}{
+		"enabled": enabled,
+		"metrics": string(body),
+		"gauges":  gauges,
+	}
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	d.SetId(path)
+
+	return nil
+}
+
+// isMetricsNotEnabledErr returns true if Vault refused to serve the metrics
+// because the telemetry for the requested format is not enabled, e.g. when
+// prometheus_retention_time is not configured.
+func isMetricsNotEnabledErr(err error) bool {
+	var respErr *api.ResponseError
+	if !errors.As(err, &respErr) || respErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+
+	for _, e := range respErr.Errors {
+		if strings.Contains(e, "not enabled") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parsePrometheusGauges returns the samples of all metrics declared as gauges
+// in the Prometheus text exposition format, keyed by the metric name including
+// its labels, e.g. `vault_core_unsealed{cluster="vault"}`.
+func parsePrometheusGauges(body string) map[string]string {
+	gaugeNames := map[string]bool{}
+	gauges := map[string]string{}
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			// e.g. "# TYPE vault_core_unsealed gauge"
+			fields := strings.Fields(line)
+			if len(fields) == 4 && fields[1] == "TYPE" && fields[3] == "gauge" {
+				gaugeNames[fields[2]] = true
+			}
+			continue
+		}
+
+		i := strings.LastIndex(line, "} ")
+		var sample, value string
+		if i >= 0 {
+			sample, value = line[:i+1], line[i+2:]
+		} else {
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				continue
+			}
+			sample, value = fields[0], fields[1]
+		}
+
+		name := sample
+		if j := strings.Index(sample, "{"); j >= 0 {
+			name = sample[:j]
+		}
+
+		if gaugeNames[name] {
+			// drop the optional timestamp
+			gauges[sample] = strings.Fields(value)[0]
+		}
+	}
+
+	return gauges
+}
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceMetrics(t *testing.T) {
+	resourceName := "data.vault_metrics.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_metrics" "test" {
+  format = "json"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "sys/metrics"),
+					resource.TestCheckResourceAttr(resourceName, "format", "json"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestMatchResourceAttr(resourceName, "metrics", regexp.MustCompile(`"Gauges"`)),
+					resource.TestCheckResourceAttr(resourceName, "gauges.%", "0"),
+				),
+			},
+			{
+				// the prometheus format is only enabled if the server's
+				// telemetry sets prometheus_retention_time.
+				Config: `
+data "vault_metrics" "test" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "format", "prometheus"),
+					resource.TestCheckResourceAttrSet(resourceName, "enabled"),
+				),
+			},
+			{
+				Config: `
+data "vault_metrics" "test" {
+  format = "statsd"
+}
+`,
+				ExpectError: regexp.MustCompile(`expected format to be one of \[prometheus json\]`),
+			},
+		},
+	})
+}
+
+func Test_parsePrometheusGauges(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want map[string]string
+	}{
+		{
+			name: "gauges-only",
+			body: `# HELP vault_core_unsealed vault_core_unsealed
+# TYPE vault_core_unsealed gauge
+vault_core_unsealed{cluster="vault-cluster"} 1
+# HELP vault_core_handle_request vault_core_handle_request
+# TYPE vault_core_handle_request summary
+vault_core_handle_request{quantile="0.5"} 0.12
+vault_core_handle_request_sum 1.5
+vault_core_handle_request_count 12
+# HELP vault_expire_num_leases vault_expire_num_leases
+# TYPE vault_expire_num_leases gauge
+vault_expire_num_leases 3 1700000000000
+`,
+			want: map[string]string{
+				`vault_core_unsealed{cluster="vault-cluster"}`: "1",
+				"vault_expire_num_leases":                      "3",
+			},
+		},
+		{
+			name: "labels-with-spaces",
+			body: `# TYPE vault_secret_kv_count gauge
+vault_secret_kv_count{mount_point="kv with space/"} 7
+`,
+			want: map[string]string{
+				`vault_secret_kv_count{mount_point="kv with space/"}`: "7",
+			},
+		},
+		{
+			name: "empty",
+			body: "",
+			want: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parsePrometheusGauges(tt.body); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePrometheusGauges() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+// mountDataSourceInfoFields are read from the mount's internal UI endpoint.
+var mountDataSourceInfoFields = []string{
+	"type",
+	"description",
+	"accessor",
+	"local",
+	"seal_wrap",
+	"external_entropy_access",
+	"options",
+}
+
+func mountDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: mountDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the secret engine mount.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the secret engine.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the mount.",
+			},
+			"accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the mount.",
+			},
+			"local": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the mount is local only.",
+			},
+			"seal_wrap": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if seal wrapping is enabled for the mount.",
+			},
+			"external_entropy_access": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the mount has access to Vault's external entropy source.",
+			},
+			"options": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The mount type specific options.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Default lease duration for tokens and secrets in seconds.",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum possible lease duration for tokens and secrets in seconds.",
+			},
+			"audit_non_hmac_request_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The keys that will not be HMAC'd by audit devices in the request data object.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"audit_non_hmac_response_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The keys that will not be HMAC'd by audit devices in the response data object.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"listing_visibility": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the mount is shown in the UI-specific listing endpoint.",
+			},
+			"passthrough_request_headers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The headers passed from the request to the plugin.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_response_headers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The headers a plugin is allowed to include in the response.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func mountDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := strings.Trim(d.Get("path").(string), "/")
+	infoPath := "sys/internal/ui/mounts/" + path
+
+	log.Printf("[DEBUG] Reading mount info from %q", infoPath)
+	resp, err := client.Logical().ReadWithContext(ctx, infoPath)
+	if err != nil {
+		if util.Is404(err) {
+			return diag.Errorf("no mount found at path %q", path)
+		}
+		return diag.Errorf("error reading mount info from %q: %s", infoPath, err)
+	}
+	log.Printf("[DEBUG] Read mount info from %q", infoPath)
+
+	if resp == nil {
+		return diag.Errorf("no mount found at path %q", path)
+	}
+
+	// the endpoint resolves any path to its enclosing mount.
+	if v, ok := resp.Data["path"].(string); ok && mountDataSourceIsNested(path, v) {
+		return diag.Errorf("no mount found at path %q, the path belongs to mount %q", path, v)
+	}
+
+	for _, k := range mountDataSourceInfoFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Reading mount tune for %q", path)
+	config, err := client.Sys().MountConfigWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading mount tune for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read mount tune for %q", path)
+
+	tune := map[string]interface{}{
+		"default_lease_ttl_seconds":    config.DefaultLeaseTTL,
+		"max_lease_ttl_seconds":        config.MaxLeaseTTL,
+		"audit_non_hmac_request_keys":  config.AuditNonHMACRequestKeys,
+		"audit_non_hmac_response_keys": config.AuditNonHMACResponseKeys,
+		"listing_visibility":           config.ListingVisibility,
+		"passthrough_request_headers":  config.PassthroughRequestHeaders,
+		"allowed_response_headers":     config.AllowedResponseHeaders,
+	}
+	for k, v := range tune {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	d.SetId(path)
+
+	return nil
+}
+
+// mountDataSourceIsNested reports whether path is nested below the mount
+// mountPath, rather than being the mount itself.
+func mountDataSourceIsNested(path, mountPath string) bool {
+	mountPath = strings.Trim(mountPath, "/")
+	return path != mountPath && strings.HasPrefix(path, mountPath+"/")
+}
@@ -0,0 +1,119 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceMount(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-mount")
+	dataSourceName := "data.vault_mount.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceMount_config(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", path),
+					resource.TestCheckResourceAttr(dataSourceName, "path", path),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "kv"),
+					resource.TestCheckResourceAttr(dataSourceName, "description", "test mount"),
+					resource.TestCheckResourceAttr(dataSourceName, "options.version", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_lease_ttl_seconds", "3600"),
+					resource.TestCheckResourceAttr(dataSourceName, "max_lease_ttl_seconds", "7200"),
+					resource.TestCheckResourceAttr(dataSourceName, "audit_non_hmac_request_keys.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "audit_non_hmac_request_keys.0", "foo"),
+					resource.TestCheckResourceAttr(dataSourceName, "listing_visibility", "unauth"),
+					resource.TestCheckResourceAttr(dataSourceName, "local", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "seal_wrap", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accessor", "vault_mount.test", "accessor"),
+				),
+			},
+			{
+				Config:      testDataSourceMount_configNested(path),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`no mount found at path "%s/nested"`, path)),
+			},
+		},
+	})
+}
+
+func testDataSourceMount_config(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                        = "%s"
+  type                        = "kv"
+  description                 = "test mount"
+  options                     = { version = "2" }
+  default_lease_ttl_seconds   = 3600
+  max_lease_ttl_seconds       = 7200
+  audit_non_hmac_request_keys = ["foo"]
+  listing_visibility          = "unauth"
+}
+
+data "vault_mount" "test" {
+  path = vault_mount.test.path
+}
+`, path)
+}
+
+func testDataSourceMount_configNested(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+}
+
+data "vault_mount" "test" {
+  path = "${vault_mount.test.path}/nested"
+}
+`, path)
+}
+
+func TestMountDataSourceIsNested(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		mountPath string
+		want      bool
+	}{
+		{
+			name:      "exact",
+			path:      "secret",
+			mountPath: "secret/",
+			want:      false,
+		},
+		{
+			name:      "nested",
+			path:      "secret/foo",
+			mountPath: "secret/",
+			want:      true,
+		},
+		{
+			name:      "shared-suffix",
+			path:      "foo",
+			mountPath: "barfoo/",
+			want:      false,
+		},
+		{
+			name:      "shared-prefix",
+			path:      "secretfoo",
+			mountPath: "secret/",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mountDataSourceIsNested(tt.path, tt.mountPath); got != tt.want {
+				t.Errorf("mountDataSourceIsNested() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func passwordPolicyGenerateDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: passwordPolicyGenerateDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the password policy to generate the password from.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated password.",
+			},
+		},
+	}
+}
+
+func passwordPolicyGenerateDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	name := d.Get("policy_name").(string)
+	path := fmt.Sprintf("sys/policies/password/%s/generate", name)
+
+	log.Printf("[DEBUG] Generating password from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error generating password from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated password from %q", path)
+
+	if resp == nil || resp.Data == nil {
+		return diag.Errorf("password policy %q not found", name)
+	}
+
+	if err := d.Set("password", resp.Data["password"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourcePasswordPolicyGenerate(t *testing.T) {
+	policyName := acctest.RandomWithPrefix("test-policy")
+	resourceName := "data.vault_password_policy_generate.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccPasswordPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePasswordPolicyGenerateConfig(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_name", policyName),
+					resource.TestMatchResourceAttr(resourceName, "password", regexp.MustCompile(`^[abcde]{20}$`)),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourcePasswordPolicyGenerateConfig(policyName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "vault_password_policy_generate" "test" {
+  policy_name = vault_password_policy.test.name
+}
+`, testAccPasswordPolicy(policyName, "length = 20\nrule \"charset\" {\n  charset = \"abcde\"\n}\n"))
+}
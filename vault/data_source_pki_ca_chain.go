@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiCAChainDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: pkiCAChainDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the PKI secret backend to read the CA chain from.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded CA chain of the PKI secret backend.",
+			},
+		},
+	}
+}
+
+func pkiCAChainDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/cert/ca_chain"
+
+	log.Printf("[DEBUG] Reading PKI CA chain %q", path)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading PKI CA chain %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read PKI CA chain %q", path)
+
+	if resp == nil {
+		return diag.Errorf("no CA chain found at %q", path)
+	}
+
+	// the chain is returned as PEM in the certificate field.
+	if err := d.Set("ca_chain", resp.Data["certificate"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func pkiCRLDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: pkiCRLDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the PKI secret backend to read the CRL from.",
+			},
+			"crl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded CRL of the PKI secret backend.",
+			},
+		},
+	}
+}
+
+func pkiCRLDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/crl/pem"
+
+	log.Printf("[DEBUG] Reading PKI CRL %q", path)
+	crl, err := pkiReadRawPEM(ctx, client, path)
+	if err != nil {
+		return diag.Errorf("error reading PKI CRL %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read PKI CRL %q", path)
+
+	if err := d.Set("crl", crl); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
+
+// pkiReadRawPEM reads one of the PKI endpoints that serve raw PEM, rather than
+// JSON, so it can not be read with the logical client.
+func pkiReadRawPEM(ctx context.Context, client *api.Client, path string) (string, error) {
+	req := client.NewRequest(http.MethodGet, "/v1/"+path)
+	resp, err := client.RawRequestWithContext(ctx, req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		if util.Is404(err) {
+			return "", fmt.Errorf("no PEM data found at %q", path)
+		}
+		return "", err
+	}
+
+	if resp == nil {
+		return "", fmt.Errorf("expected a response body, got nil response")
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
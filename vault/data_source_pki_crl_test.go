@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourcePKICRL(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+	dataSourceName := "data.vault_pki_crl.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePKICRL_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", backend+"/crl/pem"),
+					resource.TestMatchResourceAttr(dataSourceName, "crl", regexp.MustCompile(`^-----BEGIN X509 CRL-----`)),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourcePKICRL_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test.my.domain"
+  ttl         = "86400"
+}
+
+data "vault_pki_crl" "test" {
+  backend = vault_pki_secret_backend_root_cert.test.backend
+}
+`, backend)
+}
@@ -0,0 +1,138 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var pkiSerialNumberRegex = regexp.MustCompile(`^[0-9a-f]+$`)
+
+func pkiSecretBackendCertDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: pkiSecretBackendCertDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the certificate was issued by.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The serial number of the certificate, hex formatted with colons or hyphens as separators.",
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, err := normalizePKISerialNumber(i.(string)); err != nil {
+						return nil, []error{fmt.Errorf("invalid %s: %w", k, err)}
+					}
+					return nil, nil
+				},
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate.",
+			},
+			"revoked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the certificate has been revoked.",
+			},
+			"revocation_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The Unix time the certificate was revoked at, 0 if it has not been revoked.",
+			},
+			"revocation_time_rfc3339": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The RFC3339 time the certificate was revoked at, if returned by Vault.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCertDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	serial, err := normalizePKISerialNumber(d.Get("serial_number").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	path := pkiSecretBackendCertBySerialPath(backend, serial)
+
+	log.Printf("[DEBUG] Reading PKI certificate %q", path)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil && !util.Is404(err) {
+		return diag.Errorf("error reading PKI certificate %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read PKI certificate %q", path)
+
+	if resp == nil || resp.Data["certificate"] == nil || resp.Data["certificate"] == "" {
+		return diag.Errorf("certificate with serial number %q not found on PKI secret backend %q", serial, backend)
+	}
+
+	var revocationTime int64
+	if v, ok := resp.Data["revocation_time"].(json.Number); ok {
+		revocationTime, err = v.Int64()
+		if err != nil {
+			return diag.Errorf("error parsing revocation_time of PKI certificate %q: %s", path, err)
+		}
+	}
+
+	data := map[string]interface{}{
+		"certificate":             resp.Data["certificate"],
+		"revoked":                 revocationTime > 0,
+		"revocation_time":         revocationTime,
+		"revocation_time_rfc3339": resp.Data["revocation_time_rfc3339"],
+	}
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	d.SetId(path)
+
+	return nil
+}
+
+func pkiSecretBackendCertBySerialPath(backend, serial string) string {
+	return strings.Trim(backend, "/") + "/cert/" + serial
+}
+
+// normalizePKISerialNumber returns the serial number in Vault's lowercase,
+// colon-separated hex format. Hyphen-separated and unseparated serial numbers
+// are accepted.
+func normalizePKISerialNumber(serial string) (string, error) {
+	s := strings.ToLower(strings.TrimSpace(serial))
+	s = strings.NewReplacer(":", "", "-", "").Replace(s)
+	if s == "" || !pkiSerialNumberRegex.MatchString(s) {
+		return "", fmt.Errorf("serial number %q is not hex formatted", serial)
+	}
+
+	if len(s)%2 != 0 {
+		s = "0" + s
+	}
+
+	var octets []string
+	for i := 0; i < len(s); i += 2 {
+		octets = append(octets, s[i:i+2])
+	}
+
+	return strings.Join(octets, ":"), nil
+}
@@ -0,0 +1,148 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourcePKISecretBackendCert(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+	dataSourceName := "data.vault_pki_secret_backend_cert.test"
+	resourceName := "vault_pki_secret_backend_cert.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePKISecretBackendCert_config(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "certificate", resourceName, "certificate"),
+					resource.TestCheckResourceAttr(dataSourceName, "revoked", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "revocation_time", "0"),
+				),
+			},
+			{
+				Config: testDataSourcePKISecretBackendCert_config(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "certificate", resourceName, "certificate"),
+					resource.TestCheckResourceAttr(dataSourceName, "revoked", "true"),
+					resource.TestMatchResourceAttr(dataSourceName, "revocation_time", regexp.MustCompile(`^[1-9][0-9]*$`)),
+				),
+			},
+			{
+				Config:      testDataSourcePKISecretBackendCert_configNotFound(backend),
+				ExpectError: regexp.MustCompile(`certificate with serial number "de:ad:be:ef" not found`),
+			},
+		},
+	})
+}
+
+func TestNormalizePKISerialNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		serial  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "colons",
+			serial: "39:dd:2e:90:b7:23:1f:8d",
+			want:   "39:dd:2e:90:b7:23:1f:8d",
+		},
+		{
+			name:   "hyphens-uppercase",
+			serial: "39-DD-2E-90-B7-23-1F-8D",
+			want:   "39:dd:2e:90:b7:23:1f:8d",
+		},
+		{
+			name:   "no-separators",
+			serial: "39dd2e90b7231f8d",
+			want:   "39:dd:2e:90:b7:23:1f:8d",
+		},
+		{
+			name:   "odd-length",
+			serial: "f:ff",
+			want:   "0f:ff",
+		},
+		{
+			name:    "empty",
+			serial:  "",
+			wantErr: true,
+		},
+		{
+			name:    "not-hex",
+			serial:  "zz:01",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizePKISerialNumber(tt.serial)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizePKISerialNumber() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("normalizePKISerialNumber() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func testDataSourcePKISecretBackendCert_base(backend string, revoke bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds     = 86400
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test.my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.test.backend
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+  max_ttl          = "3600"
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "cert.test.my.domain"
+  ttl         = "1800"
+  revoke      = %t
+}
+`, backend, revoke)
+}
+
+func testDataSourcePKISecretBackendCert_config(backend string, revoke bool) string {
+	return testDataSourcePKISecretBackendCert_base(backend, revoke) + `
+data "vault_pki_secret_backend_cert" "test" {
+  backend       = vault_pki_secret_backend_cert.test.backend
+  serial_number = replace(upper(vault_pki_secret_backend_cert.test.serial_number), ":", "-")
+}
+`
+}
+
+func testDataSourcePKISecretBackendCert_configNotFound(backend string) string {
+	return testDataSourcePKISecretBackendCert_base(backend, true) + `
+data "vault_pki_secret_backend_cert" "test" {
+  backend       = vault_pki_secret_backend_cert.test.backend
+  serial_number = "DE-AD-BE-EF"
+}
+`
+}
@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const resultantACLPath = "sys/internal/ui/resultant-acl"
+
+func resultantACLDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: resultantACLDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"root": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the token has the root policy.",
+			},
+			"exact_paths_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded capabilities of the token, keyed on the exact paths they apply to.",
+			},
+			"glob_paths_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded capabilities of the token, keyed on the glob paths they apply to.",
+			},
+		},
+	}
+}
+
+func resultantACLDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading resultant ACL from %q", resultantACLPath)
+	resp, err := client.Logical().Read(resultantACLPath)
+	if err != nil {
+		return diag.Errorf("error reading resultant ACL from %q: %s", resultantACLPath, err)
+	}
+	log.Printf("[DEBUG] Read resultant ACL from %q", resultantACLPath)
+
+	if resp == nil || resp.Data == nil {
+		return diag.Errorf("no resultant ACL returned from %q", resultantACLPath)
+	}
+
+	root, _ := resp.Data["root"].(bool)
+	if err := d.Set("root", root); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, field := range map[string]string{
+		"exact_paths": "exact_paths_json",
+		"glob_paths":  "glob_paths_json",
+	} {
+		// paths are omitted from the response when the token has no capabilities on them.
+		paths, ok := resp.Data[k].(map[string]interface{})
+		if !ok {
+			paths = map[string]interface{}{}
+		}
+
+		b, err := json.Marshal(paths)
+		if err != nil {
+			return diag.Errorf("error encoding %s from %q: %s", k, resultantACLPath, err)
+		}
+		if err := d.Set(field, string(b)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(resultantACLPath)
+
+	return nil
+}
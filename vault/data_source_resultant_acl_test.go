@@ -0,0 +1,31 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceResultantACL(t *testing.T) {
+	resourceName := "data.vault_resultant_acl.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_resultant_acl" "test" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", resultantACLPath),
+					// the acceptance tests are run with a root token.
+					resource.TestCheckResourceAttr(resourceName, "root", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "exact_paths_json"),
+					resource.TestCheckResourceAttrSet(resourceName, "glob_paths_json"),
+				),
+			},
+		},
+	})
+}
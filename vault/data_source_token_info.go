@@ -0,0 +1,145 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const tokenLookupSelfPath = "auth/token/lookup-self"
+
+var tokenInfoFields = []string{
+	"accessor",
+	"display_name",
+	"entity_id",
+	"expire_time",
+	"issue_time",
+	"meta",
+	"orphan",
+	"path",
+	"policies",
+	"identity_policies",
+	"renewable",
+	"ttl",
+	"type",
+}
+
+func tokenInfoDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: tokenInfoDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the token.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The display name of the token.",
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the identity entity associated with the token.",
+			},
+			"expire_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the token will expire.",
+			},
+			"issue_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the token was issued.",
+			},
+			"meta": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Metadata associated with the token.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"orphan": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the token has no parent.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path from which the token was created.",
+			},
+			"policies": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The policies attached to the token.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"identity_policies": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The policies inherited from the token's identity entity and groups.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the token can be renewed.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The remaining TTL of the token in seconds, as of the last read.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the token.",
+			},
+		},
+	}
+}
+
+func tokenInfoDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading token info from %q", tokenLookupSelfPath)
+	resp, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		return diag.Errorf("error reading token info from %q: %s", tokenLookupSelfPath, err)
+	}
+	log.Printf("[DEBUG] Read token info from %q", tokenLookupSelfPath)
+
+	if resp == nil || resp.Data == nil {
+		return diag.Errorf("no token info returned from %q", tokenLookupSelfPath)
+	}
+
+	for _, k := range tokenInfoFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	// tokens without an accessor, e.g. batch tokens, fall back to the lookup path.
+	id := tokenLookupSelfPath
+	if v, ok := resp.Data["accessor"].(string); ok && v != "" {
+		id = v
+	}
+	d.SetId(id)
+
+	return nil
+}
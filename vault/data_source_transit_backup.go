@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitBackupDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitBackupDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to back up.",
+			},
+			"backup": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The backup of the key, including all of its versions and configuration.",
+			},
+		},
+	}
+}
+
+func transitBackupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	name := d.Get("name").(string)
+
+	keyPath := backend + "/keys/" + name
+	log.Printf("[DEBUG] Reading transit key %q", keyPath)
+	key, err := client.Logical().Read(keyPath)
+	if err != nil {
+		return fmt.Errorf("error reading transit key %q: %s", keyPath, err)
+	}
+	if key == nil {
+		return fmt.Errorf("no transit key found at %q", keyPath)
+	}
+	log.Printf("[DEBUG] Read transit key %q", keyPath)
+
+	// Vault only allows backing up keys that are both exportable and allow
+	// plaintext backups.
+	for _, k := range []string{"exportable", "allow_plaintext_backup"} {
+		if v, ok := key.Data[k].(bool); !ok || !v {
+			return fmt.Errorf("transit key %q does not allow backups, "+
+				"both exportable and allow_plaintext_backup must be enabled", keyPath)
+		}
+	}
+
+	path := backend + "/backup/" + name
+	log.Printf("[DEBUG] Backing up transit key %q", keyPath)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error backing up transit key %q: %s", keyPath, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no backup returned for transit key %q", keyPath)
+	}
+	log.Printf("[DEBUG] Backed up transit key %q", keyPath)
+
+	d.SetId(path)
+	if err := d.Set("backup", resp.Data["backup"]); err != nil {
+		return err
+	}
+
+	return nil
+}
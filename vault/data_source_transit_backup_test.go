@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitBackup(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resourceName := "data.vault_transit_backup.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceTransitBackup_config(backend, false),
+				ExpectError: regexp.MustCompile(`does not allow backups`),
+			},
+			{
+				Config: testDataSourceTransitBackup_config(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/backup/test"),
+					resource.TestCheckResourceAttrSet(resourceName, "backup"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitBackup_config(backend string, allowBackup bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend                = vault_mount.test.path
+  name                   = "test"
+  deletion_allowed       = true
+  exportable             = %t
+  allow_plaintext_backup = %t
+}
+
+data "vault_transit_backup" "test" {
+  backend = vault_mount.test.path
+  name    = vault_transit_secret_backend_key.test.name
+}
+`, backend, allowBackup, allowBackup)
+}
@@ -0,0 +1,113 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitDataKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitDataKeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the encryption key to use.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "wrapped",
+				Description:  "The type of data key to generate. If plaintext, the plaintext key will be returned along with the ciphertext. If wrapped, only the ciphertext value will be returned.",
+				ValidateFunc: validation.StringInSlice([]string{"plaintext", "wrapped"}, false),
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the context for key derivation",
+			},
+			"nonce": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Base64 encoded nonce value. Only used when convergent encryption is enabled for the key.",
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      256,
+				Description:  "Number of bits in the desired key. Can be 128, 256, or 512.",
+				ValidateFunc: validation.IntInSlice([]int{128, 256, 512}),
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data key encrypted with the named transit key.",
+				Sensitive:   true,
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base64 encoded plaintext data key. Only set when type is plaintext.",
+				Sensitive:   true,
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the transit key used to encrypt the data key.",
+			},
+		},
+	}
+}
+
+func transitDataKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	keyType := d.Get("type").(string)
+
+	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
+	payload := map[string]interface{}{
+		"context": context,
+		"bits":    d.Get("bits").(int),
+	}
+	if v, ok := d.GetOk("nonce"); ok {
+		payload["nonce"] = v
+	}
+
+	resp, err := client.Logical().Write(backend+"/datakey/"+keyType+"/"+key, payload)
+	if err != nil {
+		return fmt.Errorf("issue generating data key with key: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no response returned generating data key with key %q", key)
+	}
+
+	ciphertext := resp.Data["ciphertext"].(string)
+
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(ciphertext)))
+	d.Set("ciphertext", ciphertext)
+	d.Set("key_version", resp.Data["key_version"])
+	if v, ok := resp.Data["plaintext"]; ok {
+		d.Set("plaintext", v)
+	} else {
+		d.Set("plaintext", "")
+	}
+
+	return nil
+}
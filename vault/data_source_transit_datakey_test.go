@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitDataKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitDataKey_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_transit_datakey.plaintext", "ciphertext"),
+					resource.TestCheckResourceAttrSet("data.vault_transit_datakey.plaintext", "plaintext"),
+					resource.TestCheckResourceAttr("data.vault_transit_datakey.plaintext", "key_version", "1"),
+					resource.TestCheckResourceAttrSet("data.vault_transit_datakey.wrapped", "ciphertext"),
+					resource.TestCheckResourceAttr("data.vault_transit_datakey.wrapped", "plaintext", ""),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitDataKey_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+}
+
+data "vault_transit_datakey" "plaintext" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  type    = "plaintext"
+  bits    = 512
+}
+
+data "vault_transit_datakey" "wrapped" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+}
+`, backend)
+}
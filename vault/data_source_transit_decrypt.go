@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
@@ -35,6 +36,12 @@ func transitDecryptDataSource() *schema.Resource {
 				Optional:    true,
 				Description: "Specifies the context for key derivation",
 			},
+			"nonce": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Base64 encoded nonce value used during encryption. Only used when convergent encryption is enabled for the key.",
+				ValidateFunc: validation.StringIsBase64,
+			},
 			"ciphertext": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -59,13 +66,22 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		"ciphertext": ciphertext,
 		"context":    context,
 	}
+	if v, ok := d.GetOk("nonce"); ok {
+		payload["nonce"] = v
+	}
 
 	decryptedData, err := client.Logical().Write(backend+"/decrypt/"+key, payload)
 	if err != nil {
-		return fmt.Errorf("issue encrypting with key: %s", err)
+		return fmt.Errorf("issue decrypting with key: %s", err)
+	}
+	if decryptedData == nil {
+		return fmt.Errorf("no response returned decrypting with key %q", key)
 	}
 
-	plaintext, _ := base64.StdEncoding.DecodeString(decryptedData.Data["plaintext"].(string))
+	plaintext, err := base64.StdEncoding.DecodeString(decryptedData.Data["plaintext"].(string))
+	if err != nil {
+		return fmt.Errorf("error decoding plaintext returned from Vault: %s", err)
+	}
 
 	d.SetId(base64.StdEncoding.EncodeToString([]byte(ciphertext)))
 	d.Set("plaintext", string(plaintext))
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
@@ -40,10 +41,17 @@ func transitEncryptDataSource() *schema.Resource {
 				Optional:    true,
 				Description: "The version of the key to use for encryption",
 			},
+			"nonce": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Base64 encoded nonce value. Only used when convergent encryption is enabled for the key.",
+				ValidateFunc: validation.StringIsBase64,
+			},
 			"ciphertext": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Transit encrypted cipher text.",
+				Sensitive:   true,
 			},
 		},
 	}
@@ -66,11 +74,17 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		"context":     context,
 		"key_version": keyVersion,
 	}
+	if v, ok := d.GetOk("nonce"); ok {
+		payload["nonce"] = v
+	}
 
 	encryptedData, err := client.Logical().Write(backend+"/encrypt/"+key, payload)
 	if err != nil {
 		return fmt.Errorf("issue encrypting with key: %s", err)
 	}
+	if encryptedData == nil {
+		return fmt.Errorf("no response returned encrypting with key %q", key)
+	}
 
 	cipherText := encryptedData.Data["ciphertext"]
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -66,3 +67,52 @@ func testDataSourceTransitEncrypt_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestDataSourceTransitEncrypt_derived(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitEncrypt_derivedConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_transit_encrypt.test", "ciphertext"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitEncrypt_derivedConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name                  = "test"
+  backend               = vault_mount.test.path
+  derived               = true
+  convergent_encryption = true
+  deletion_allowed      = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend     = vault_mount.test.path
+  key         = vault_transit_secret_backend_key.test.name
+  plaintext   = "foo"
+  context     = "bar"
+  key_version = 1
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = vault_mount.test.path
+  key        = vault_transit_secret_backend_key.test.name
+  ciphertext = data.vault_transit_encrypt.test.ciphertext
+  context    = "bar"
+}
+`, backend)
+}
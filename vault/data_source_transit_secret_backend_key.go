@@ -0,0 +1,176 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+// transitSecretBackendKeyDataSourceFields are read from the key as is.
+var transitSecretBackendKeyDataSourceFields = []string{
+	"type",
+	"latest_version",
+	"min_available_version",
+	"min_decryption_version",
+	"min_encryption_version",
+	"deletion_allowed",
+	"derived",
+	"exportable",
+	"allow_plaintext_backup",
+	"auto_rotate_period",
+	"supports_encryption",
+	"supports_decryption",
+	"supports_derivation",
+	"supports_signing",
+}
+
+func transitSecretBackendKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitSecretBackendKeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the encryption key.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the key.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of key versions in the keyring, ordered by version.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest key version in use in the keyring.",
+			},
+			"min_available_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version available for use.",
+			},
+			"min_decryption_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version to use for decryption.",
+			},
+			"min_encryption_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version to use for encryption.",
+			},
+			"deletion_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key is allowed to be deleted.",
+			},
+			"derived": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether key derivation is enabled for the key.",
+			},
+			"convergent_encryption": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether convergent encryption is enabled for the key.",
+			},
+			"exportable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key is exportable.",
+			},
+			"allow_plaintext_backup": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether plaintext backups of the key are allowed.",
+			},
+			"auto_rotate_period": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Amount of seconds the key lives before being automatically rotated, 0 if automatic rotation is disabled.",
+			},
+			"supports_encryption": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports encryption, based on key type.",
+			},
+			"supports_decryption": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports decryption, based on key type.",
+			},
+			"supports_derivation": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports derivation, based on key type.",
+			},
+			"supports_signing": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the key supports signing, based on key type.",
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := backend + "/keys/" + d.Get("name").(string)
+
+	log.Printf("[DEBUG] Reading transit key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transit key %q: %s", path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no transit key found at %q", path)
+	}
+	log.Printf("[DEBUG] Read transit key %q", path)
+
+	keys, err := transitKeyVersions(resp.Data["keys"])
+	if err != nil {
+		return fmt.Errorf("error reading key versions of %q: %s", path, err)
+	}
+	if err := d.Set("keys", keys); err != nil {
+		return err
+	}
+
+	// convergent_encryption is only returned for derived keys.
+	convergentEncryption, _ := resp.Data["convergent_encryption"].(bool)
+	if err := d.Set("convergent_encryption", convergentEncryption); err != nil {
+		return err
+	}
+
+	for _, k := range transitSecretBackendKeyDataSourceFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	d.SetId(path)
+
+	return nil
+}
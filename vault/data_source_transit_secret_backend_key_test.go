@@ -0,0 +1,145 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitSecretBackendKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resourceName := "data.vault_transit_secret_backend_key.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitSecretBackendKey_config(backend, "ed25519"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/keys/test"),
+					resource.TestCheckResourceAttr(resourceName, "type", "ed25519"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_decryption_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_encryption_version", "0"),
+					resource.TestCheckResourceAttr(resourceName, "exportable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "supports_signing", "true"),
+					resource.TestCheckResourceAttr(resourceName, "supports_encryption", "false"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "keys.0.version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "keys.0.public_key"),
+				),
+			},
+			{
+				Config: testDataSourceTransitSecretBackendKey_config(backend, "aes256-gcm96"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "aes256-gcm96"),
+					resource.TestCheckResourceAttr(resourceName, "supports_encryption", "true"),
+					resource.TestCheckResourceAttr(resourceName, "supports_decryption", "true"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "keys.0.version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "keys.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func Test_transitKeyVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    interface{}
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name: "symmetric",
+			keys: map[string]interface{}{
+				"10": json.Number("1700000010"),
+				"2":  json.Number("1700000002"),
+				"1":  json.Number("1700000001"),
+			},
+			want: []interface{}{
+				map[string]interface{}{"id": "1700000001", "version": "1"},
+				map[string]interface{}{"id": "1700000002", "version": "2"},
+				map[string]interface{}{"id": "1700000010", "version": "10"},
+			},
+		},
+		{
+			name: "asymmetric",
+			keys: map[string]interface{}{
+				"2": map[string]interface{}{
+					"name":          "ed25519",
+					"public_key":    "key2",
+					"creation_time": "2024-01-02T00:00:00Z",
+				},
+				"1": map[string]interface{}{
+					"name":          "ed25519",
+					"public_key":    "key1",
+					"creation_time": "2024-01-01T00:00:00Z",
+				},
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"name":          "ed25519",
+					"public_key":    "key1",
+					"creation_time": "2024-01-01T00:00:00Z",
+					"version":       "1",
+				},
+				map[string]interface{}{
+					"name":          "ed25519",
+					"public_key":    "key2",
+					"creation_time": "2024-01-02T00:00:00Z",
+					"version":       "2",
+				},
+			},
+		},
+		{
+			name:    "invalid-version",
+			keys:    map[string]interface{}{"latest": json.Number("1")},
+			wantErr: true,
+		},
+		{
+			name:    "not-a-map",
+			keys:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transitKeyVersions(tt.keys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("transitKeyVersions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("transitKeyVersions() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testDataSourceTransitSecretBackendKey_config(backend, keyType string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.test.path
+  name             = "test"
+  type             = "%s"
+  deletion_allowed = true
+}
+
+data "vault_transit_secret_backend_key" "test" {
+  backend = vault_mount.test.path
+  name    = vault_transit_secret_backend_key.test.name
+}
+`, backend, keyType)
+}
@@ -0,0 +1,151 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var (
+	transitHashAlgorithms = []string{
+		"sha1", "sha2-224", "sha2-256", "sha2-384", "sha2-512",
+		"sha3-224", "sha3-256", "sha3-384", "sha3-512", "none",
+	}
+	transitSignatureAlgorithms  = []string{"pss", "pkcs1v15"}
+	transitMarshalingAlgorithms = []string{"asn1", "jws"}
+
+	// transitSignFields are the optional request fields shared by the sign
+	// and verify endpoints.
+	transitSignFields = []string{
+		"hash_algorithm",
+		"signature_algorithm",
+		"marshaling_algorithm",
+		"prehashed",
+	}
+)
+
+// transitSignSchema returns the schema fields shared by the
+// vault_transit_sign and vault_transit_verify data sources.
+func transitSignSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the signing key to use.",
+		},
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The Transit secret backend the key belongs to.",
+		},
+		"input": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Base64 encoded input data.",
+			ValidateFunc: validation.StringIsBase64,
+		},
+		"context": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the context for key derivation. Required if key derivation is enabled.",
+		},
+		"hash_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies the hash algorithm to use.",
+			ValidateFunc: validation.StringInSlice(transitHashAlgorithms, false),
+		},
+		"signature_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies the RSA signature algorithm to use. One of pss or pkcs1v15.",
+			ValidateFunc: validation.StringInSlice(transitSignatureAlgorithms, false),
+		},
+		"marshaling_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies the way in which the ECDSA signature is marshaled. One of asn1 or jws.",
+			ValidateFunc: validation.StringInSlice(transitMarshalingAlgorithms, false),
+		},
+		"prehashed": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Set to true when the input is already hashed.",
+		},
+	}
+}
+
+// transitSignRequestData builds the request payload for the sign and verify
+// endpoints, only sending the optional fields that have been configured.
+func transitSignRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"input": d.Get("input"),
+	}
+	if v, ok := d.GetOk("context"); ok {
+		data["context"] = base64.StdEncoding.EncodeToString([]byte(v.(string)))
+	}
+
+	for _, k := range transitSignFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	return data
+}
+
+func transitSignDataSource() *schema.Resource {
+	s := transitSignSchema()
+	s["key_version"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "The version of the key to use for signing. If not set, uses the latest version.",
+	}
+	s["signature"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The signature returned from Vault.",
+	}
+
+	return &schema.Resource{
+		Read:   transitSignDataSourceRead,
+		Schema: s,
+	}
+}
+
+func transitSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+
+	payload := transitSignRequestData(d)
+	if v, ok := d.GetOk("key_version"); ok {
+		payload["key_version"] = v
+	}
+
+	resp, err := client.Logical().Write(backend+"/sign/"+key, payload)
+	if err != nil {
+		return fmt.Errorf("issue signing with key: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no response returned signing with key %q", key)
+	}
+
+	signature, ok := resp.Data["signature"].(string)
+	if !ok {
+		return fmt.Errorf("no signature returned signing with key %q", key)
+	}
+
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(signature)))
+	d.Set("signature", signature)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitSign(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitSign_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_transit_sign.test", "signature"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.test", "valid", "true"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.invalid", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitSign_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  type             = "ecdsa-p256"
+  deletion_allowed = true
+}
+
+data "vault_transit_sign" "test" {
+  backend        = vault_mount.test.path
+  key            = vault_transit_secret_backend_key.test.name
+  input          = base64encode("foo")
+  hash_algorithm = "sha2-512"
+}
+
+data "vault_transit_verify" "test" {
+  backend        = vault_mount.test.path
+  key            = vault_transit_secret_backend_key.test.name
+  input          = base64encode("foo")
+  hash_algorithm = "sha2-512"
+  signature      = data.vault_transit_sign.test.signature
+}
+
+data "vault_transit_verify" "invalid" {
+  backend        = vault_mount.test.path
+  key            = vault_transit_secret_backend_key.test.name
+  input          = base64encode("bar")
+  hash_algorithm = "sha2-512"
+  signature      = data.vault_transit_sign.test.signature
+}
+`, backend)
+}
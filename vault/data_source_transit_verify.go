@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitVerifyDataSource() *schema.Resource {
+	s := transitSignSchema()
+	s["signature"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The signature to verify, as returned by the sign endpoint.",
+	}
+	s["valid"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the signature is valid for the given input.",
+	}
+
+	return &schema.Resource{
+		Read:   transitVerifyDataSourceRead,
+		Schema: s,
+	}
+}
+
+func transitVerifyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	signature := d.Get("signature").(string)
+
+	payload := transitSignRequestData(d)
+	payload["signature"] = signature
+
+	resp, err := client.Logical().Write(backend+"/verify/"+key, payload)
+	if err != nil {
+		return fmt.Errorf("issue verifying with key: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no response returned verifying with key %q", key)
+	}
+
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(signature)))
+	d.Set("valid", resp.Data["valid"])
+
+	return nil
+}
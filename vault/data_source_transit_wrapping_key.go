@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitWrappingKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitWrappingKeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend to read the wrapping key from.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded public RSA key used to wrap keys for import.",
+			},
+		},
+	}
+}
+
+func transitWrappingKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	path := backend + "/wrapping_key"
+
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transit wrapping key from %q: %s", path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no transit wrapping key found at %q", path)
+	}
+
+	d.SetId(path)
+	d.Set("public_key", resp.Data["public_key"])
+
+	return nil
+}
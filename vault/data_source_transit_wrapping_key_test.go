@@ -0,0 +1,46 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceTransitWrappingKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitWrappingKey_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_wrapping_key.test", "id", backend+"/wrapping_key"),
+					resource.TestMatchResourceAttr("data.vault_transit_wrapping_key.test", "public_key",
+						regexp.MustCompile(`^-----BEGIN PUBLIC KEY-----`)),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitWrappingKey_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+data "vault_transit_wrapping_key" "test" {
+  backend = vault_mount.test.path
+}
+`, backend)
+}
@@ -0,0 +1,116 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const wrappingUnwrapPath = "sys/wrapping/unwrap"
+
+func unwrapDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: unwrapDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"token": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "The wrapping token to unwrap.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			consts.FieldDataJSON: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "JSON-encoded unwrapped data.",
+			},
+			consts.FieldData: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Map of strings of the unwrapped data.",
+			},
+			consts.FieldLeaseID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier of the unwrapped secret.",
+			},
+			consts.FieldLeaseDuration: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration of the unwrapped secret in seconds.",
+			},
+			consts.FieldLeaseRenewable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of the unwrapped secret's lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func unwrapDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Unwrapping wrapping token via %q", wrappingUnwrapPath)
+	secret, err := client.Logical().Unwrap(d.Get("token").(string))
+	if err != nil {
+		if isWrappingTokenInvalidErr(err) {
+			return diag.Errorf("the wrapping token is either invalid, expired or has already been unwrapped, "+
+				"wrapping tokens can only be unwrapped once: %s", err)
+		}
+		return diag.Errorf("error unwrapping wrapping token via %q: %s", wrappingUnwrapPath, err)
+	}
+	log.Printf("[DEBUG] Unwrapped wrapping token via %q", wrappingUnwrapPath)
+
+	if secret == nil {
+		return diag.Errorf("no data returned from %q", wrappingUnwrapPath)
+	}
+
+	// Ignoring error because this value came from JSON in the
+	// first place so no reason why it should fail to re-encode.
+	jsonDataBytes, _ := json.Marshal(secret.Data)
+	if err := d.Set(consts.FieldDataJSON, string(jsonDataBytes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// non-string values are JSON encoded, since the map can only hold strings.
+	dataMap := map[string]string{}
+	for k, v := range secret.Data {
+		if vs, ok := v.(string); ok {
+			dataMap[k] = vs
+		} else {
+			vBytes, _ := json.Marshal(v)
+			dataMap[k] = string(vBytes)
+		}
+	}
+	if err := d.Set(consts.FieldData, dataMap); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(consts.FieldLeaseID, secret.LeaseID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldLeaseDuration, secret.LeaseDuration); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldLeaseRenewable, secret.Renewable); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(wrappingUnwrapPath)
+
+	return nil
+}
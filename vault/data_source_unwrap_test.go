@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceUnwrap(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	resourceName := "data.vault_unwrap.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceUnwrapConfig(backend, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "data.secret_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "data.secret_id_accessor",
+						"vault_approle_auth_backend_role_secret_id.secret_id", "wrapping_accessor"),
+					resource.TestCheckResourceAttrSet(resourceName, "data_json"),
+				),
+				// the post-apply refresh unwraps the token a second time.
+				ExpectError: regexp.MustCompile(`has already been unwrapped`),
+			},
+		},
+	})
+}
+
+func testDataSourceUnwrapConfig(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "%s"
+}
+
+resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
+  backend      = vault_auth_backend.approle.path
+  role_name    = vault_approle_auth_backend_role.role.role_name
+  wrapping_ttl = "10m"
+
+  with_wrapped_accessor = true
+}
+
+data "vault_unwrap" "test" {
+  token = vault_approle_auth_backend_role_secret_id.secret_id.wrapping_token
+}
+`, backend, role)
+}
@@ -0,0 +1,229 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const usageCountersPath = "sys/internal/counters/activity"
+
+// usageCountersCountFields are the client count fields that Vault reports for
+// the total, for each namespace and for each mount.
+var usageCountersCountFields = []string{
+	"clients",
+	"entity_clients",
+	"non_entity_clients",
+}
+
+func usageCountersCountsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"clients": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total number of clients.",
+		},
+		"entity_clients": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of entity clients.",
+		},
+		"non_entity_clients": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of non-entity clients.",
+		},
+	}
+}
+
+func usageCountersDataSource() *schema.Resource {
+	namespaceSchema := usageCountersCountsSchema()
+	namespaceSchema["namespace_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the namespace.",
+	}
+	namespaceSchema["namespace_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The path of the namespace.",
+	}
+
+	mountSchema := usageCountersCountsSchema()
+	mountSchema["mount_path"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The path of the mount.",
+	}
+	namespaceSchema["mounts"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Client counts for each mount in the namespace.",
+		Elem:        &schema.Resource{Schema: mountSchema},
+	}
+
+	return &schema.Resource{
+		ReadContext: usageCountersDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "The start of the reporting period, as an RFC3339 timestamp. " +
+					"Defaults to the start of the configured billing period.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "The end of the reporting period, as an RFC3339 timestamp. " +
+					"Defaults to the end of the previous month.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"total": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Client counts across all namespaces.",
+				Elem:        &schema.Resource{Schema: usageCountersCountsSchema()},
+			},
+			"by_namespace": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Client counts for each namespace.",
+				Elem:        &schema.Resource{Schema: namespaceSchema},
+			},
+		},
+	}
+}
+
+func usageCountersDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	query := map[string][]string{}
+	for _, k := range []string{"start_time", "end_time"} {
+		if v, ok := d.GetOk(k); ok {
+			query[k] = []string{v.(string)}
+		}
+	}
+
+	log.Printf("[DEBUG] Reading client counts from %q", usageCountersPath)
+	resp, err := client.Logical().ReadWithData(usageCountersPath, query)
+	if err != nil {
+		return diag.Errorf("error reading client counts from %q: %s", usageCountersPath, err)
+	}
+	log.Printf("[DEBUG] Read client counts from %q", usageCountersPath)
+
+	d.SetId(usageCountersPath)
+
+	// Vault returns no data when there is no activity for the requested period.
+	var data map[string]interface{}
+	if resp != nil && resp.Data != nil {
+		data = resp.Data
+	}
+
+	for _, k := range []string{"start_time", "end_time"} {
+		if v, ok := data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	var total []interface{}
+	if v, ok := data["total"].(map[string]interface{}); ok {
+		counts, err := flattenUsageCounts(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		total = append(total, counts)
+	}
+	if err := d.Set("total", total); err != nil {
+		return diag.FromErr(err)
+	}
+
+	byNamespace, err := flattenUsageCountsByNamespace(data["by_namespace"])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("by_namespace", byNamespace); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func flattenUsageCountsByNamespace(v interface{}) ([]interface{}, error) {
+	namespaces, _ := v.([]interface{})
+
+	result := make([]interface{}, 0, len(namespaces))
+	for _, n := range namespaces {
+		ns, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		counts, _ := ns["counts"].(map[string]interface{})
+		m, err := flattenUsageCounts(counts)
+		if err != nil {
+			return nil, err
+		}
+		m["namespace_id"] = ns["namespace_id"]
+		m["namespace_path"] = ns["namespace_path"]
+
+		mountsRaw, _ := ns["mounts"].([]interface{})
+		mounts := make([]interface{}, 0, len(mountsRaw))
+		for _, r := range mountsRaw {
+			mount, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			counts, _ := mount["counts"].(map[string]interface{})
+			mm, err := flattenUsageCounts(counts)
+			if err != nil {
+				return nil, err
+			}
+			mm["mount_path"] = mount["mount_path"]
+			mounts = append(mounts, mm)
+		}
+		m["mounts"] = mounts
+
+		result = append(result, m)
+	}
+
+	return result, nil
+}
+
+func flattenUsageCounts(counts map[string]interface{}) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	for _, k := range usageCountersCountFields {
+		v, ok := counts[k]
+		if !ok || v == nil {
+			continue
+		}
+
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T for client count %q", v, k)
+		}
+		i, err := n.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid client count %q: %w", k, err)
+		}
+		m[k] = int(i)
+	}
+
+	return m, nil
+}
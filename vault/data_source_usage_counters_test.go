@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceUsageCounters(t *testing.T) {
+	resourceName := "data.vault_usage_counters.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_usage_counters" "test" {
+  start_time = "2022-01-01T00:00:00Z"
+  end_time   = "2022-12-31T23:59:59Z"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", usageCountersPath),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenUsageCountsByNamespace(t *testing.T) {
+	counts := func(clients, entity, nonEntity string) map[string]interface{} {
+		return map[string]interface{}{
+			"clients":            json.Number(clients),
+			"entity_clients":     json.Number(entity),
+			"non_entity_clients": json.Number(nonEntity),
+		}
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"namespace_id":   "root",
+			"namespace_path": "",
+			"counts":         counts("3", "2", "1"),
+			"mounts": []interface{}{
+				map[string]interface{}{
+					"mount_path": "auth/userpass/",
+					"counts":     counts("2", "2", "0"),
+				},
+				map[string]interface{}{
+					"mount_path": "no mount accessor (pre-1.10 upgrade?)",
+					"counts":     counts("1", "0", "1"),
+				},
+			},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"namespace_id":       "root",
+			"namespace_path":     "",
+			"clients":            3,
+			"entity_clients":     2,
+			"non_entity_clients": 1,
+			"mounts": []interface{}{
+				map[string]interface{}{
+					"mount_path":         "auth/userpass/",
+					"clients":            2,
+					"entity_clients":     2,
+					"non_entity_clients": 0,
+				},
+				map[string]interface{}{
+					"mount_path":         "no mount accessor (pre-1.10 upgrade?)",
+					"clients":            1,
+					"entity_clients":     0,
+					"non_entity_clients": 1,
+				},
+			},
+		},
+	}
+
+	actual, err := flattenUsageCountsByNamespace(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %#v, actual %#v", expected, actual)
+	}
+
+	if _, err := flattenUsageCounts(map[string]interface{}{"clients": "3"}); err == nil {
+		t.Fatal("expected an error for a non-numeric client count")
+	}
+}
@@ -0,0 +1,112 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func versionDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: versionDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full version of the Vault server, e.g. 1.12.0+ent.",
+			},
+			"version_core": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the Vault server without any pre-release or metadata, e.g. 1.12.0.",
+			},
+			"version_prerelease": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The pre-release of the Vault server version, e.g. rc1.",
+			},
+			"version_metadata": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The metadata of the Vault server version, e.g. ent.hsm.",
+			},
+			"build_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The build date of the Vault server, if returned by Vault.",
+			},
+			"is_enterprise": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the Vault server is an Enterprise build.",
+			},
+		},
+	}
+}
+
+func versionDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading Vault version from seal status")
+	status, err := client.Sys().SealStatusWithContext(ctx)
+	if err != nil {
+		return diag.Errorf("error reading seal status: %s", err)
+	}
+	log.Printf("[DEBUG] Read Vault version from seal status")
+
+	if status.Version == "" {
+		return diag.Errorf("no version returned by Vault")
+	}
+
+	core, prerelease, metadata := parseVaultVersion(status.Version)
+
+	data := map[string]interface{}{
+		"version":            status.Version,
+		"version_core":       core,
+		"version_prerelease": prerelease,
+		"version_metadata":   metadata,
+		"build_date":         status.BuildDate,
+		"is_enterprise":      isEnterpriseVersionMetadata(metadata),
+	}
+	for k, v := range data {
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	d.SetId(status.Version)
+
+	return nil
+}
+
+// parseVaultVersion splits a Vault version, e.g. 1.12.0-rc1+ent.hsm, into its
+// core version, pre-release and metadata.
+func parseVaultVersion(v string) (core, prerelease, metadata string) {
+	core = strings.TrimPrefix(v, "v")
+	if i := strings.Index(core, "+"); i >= 0 {
+		core, metadata = core[:i], core[i+1:]
+	}
+	if i := strings.Index(core, "-"); i >= 0 {
+		core, prerelease = core[:i], core[i+1:]
+	}
+	return core, prerelease, metadata
+}
+
+// isEnterpriseVersionMetadata returns true if the version metadata denotes an
+// Enterprise build, e.g. ent or ent.hsm.fips1402.
+func isEnterpriseVersionMetadata(metadata string) bool {
+	for _, m := range strings.Split(metadata, ".") {
+		if m == "ent" {
+			return true
+		}
+	}
+	return false
+}
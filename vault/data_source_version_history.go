@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const versionHistoryPath = "sys/version-history"
+
+// versionHistoryFields are read from the key_info of each version.
+var versionHistoryFields = []string{
+	"timestamp_installed",
+	"previous_version",
+	"build_date",
+}
+
+func versionHistoryDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: versionHistoryDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions the Vault cluster has run, in the order they were installed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Vault version.",
+						},
+						"timestamp_installed": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The RFC3339 time the version was first run at.",
+						},
+						"previous_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version that was run before this version, if any.",
+						},
+						"build_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The build date of the version, if returned by Vault.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func versionHistoryDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading version history from %q", versionHistoryPath)
+	resp, err := client.Logical().ListWithContext(ctx, versionHistoryPath)
+	if err != nil {
+		return diag.Errorf("error reading version history from %q: %s", versionHistoryPath, err)
+	}
+	log.Printf("[DEBUG] Read version history from %q", versionHistoryPath)
+
+	versions := make([]map[string]interface{}, 0)
+	if resp != nil {
+		keys, _ := resp.Data["keys"].([]interface{})
+		keyInfo, _ := resp.Data["key_info"].(map[string]interface{})
+		for _, k := range keys {
+			version := k.(string)
+			v := map[string]interface{}{
+				"version": version,
+			}
+			if info, ok := keyInfo[version].(map[string]interface{}); ok {
+				for _, f := range versionHistoryFields {
+					if fv, ok := info[f].(string); ok {
+						v[f] = fv
+					}
+				}
+			}
+			versions = append(versions, v)
+		}
+	}
+
+	if err := d.Set("versions", versions); err != nil {
+		return diag.Errorf("error setting state key %q: %s", "versions", err)
+	}
+
+	d.SetId(versionHistoryPath)
+
+	return nil
+}
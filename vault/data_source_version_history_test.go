@@ -0,0 +1,31 @@
+package vault
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceVersionHistory(t *testing.T) {
+	resourceName := "data.vault_version_history.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "vault_version_history" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "sys/version-history"),
+					resource.TestMatchResourceAttr(resourceName, "versions.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr(resourceName, "versions.0.version", regexp.MustCompile(`^\d+\.\d+\.\d+`)),
+					resource.TestMatchResourceAttr(resourceName, "versions.0.timestamp_installed",
+						regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+				),
+			},
+		},
+	})
+}
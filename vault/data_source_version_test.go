@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceVersion(t *testing.T) {
+	resourceName := "data.vault_version.test"
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE") != ""
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "vault_version" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "version", regexp.MustCompile(`^\d+\.\d+\.\d+`)),
+					resource.TestMatchResourceAttr(resourceName, "version_core", regexp.MustCompile(`^\d+\.\d+\.\d+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "version"),
+					resource.TestCheckResourceAttr(resourceName, "is_enterprise", strconv.FormatBool(isEnterprise)),
+				),
+			},
+		},
+	})
+}
+
+func TestParseVaultVersion(t *testing.T) {
+	tests := []struct {
+		name           string
+		version        string
+		wantCore       string
+		wantPrerelease string
+		wantMetadata   string
+		wantEnterprise bool
+	}{
+		{
+			name:     "oss",
+			version:  "1.12.0",
+			wantCore: "1.12.0",
+		},
+		{
+			name:           "ent",
+			version:        "1.12.0+ent",
+			wantCore:       "1.12.0",
+			wantMetadata:   "ent",
+			wantEnterprise: true,
+		},
+		{
+			name:           "ent-hsm-prerelease",
+			version:        "1.13.0-rc1+ent.hsm",
+			wantCore:       "1.13.0",
+			wantPrerelease: "rc1",
+			wantMetadata:   "ent.hsm",
+			wantEnterprise: true,
+		},
+		{
+			name:           "oss-prerelease",
+			version:        "1.13.0-beta1",
+			wantCore:       "1.13.0",
+			wantPrerelease: "beta1",
+		},
+		{
+			name:         "oss-metadata",
+			version:      "v1.12.0+entitlements",
+			wantCore:     "1.12.0",
+			wantMetadata: "entitlements",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, prerelease, metadata := parseVaultVersion(tt.version)
+			if core != tt.wantCore {
+				t.Errorf("parseVaultVersion() core = %q, want %q", core, tt.wantCore)
+			}
+			if prerelease != tt.wantPrerelease {
+				t.Errorf("parseVaultVersion() prerelease = %q, want %q", prerelease, tt.wantPrerelease)
+			}
+			if metadata != tt.wantMetadata {
+				t.Errorf("parseVaultVersion() metadata = %q, want %q", metadata, tt.wantMetadata)
+			}
+			if got := isEnterpriseVersionMetadata(metadata); got != tt.wantEnterprise {
+				t.Errorf("isEnterpriseVersionMetadata() = %v, want %v", got, tt.wantEnterprise)
+			}
+		})
+	}
+}
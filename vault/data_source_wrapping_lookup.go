@@ -0,0 +1,97 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const wrappingLookupPath = "sys/wrapping/lookup"
+
+func wrappingLookupDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: wrappingLookupDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"token": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "The wrapping token to look up.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"creation_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The TTL of the wrapping token in seconds, as set when it was created.",
+			},
+			"creation_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the wrapping token was created.",
+			},
+			"creation_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API path of the request that created the wrapping token.",
+			},
+		},
+	}
+}
+
+func wrappingLookupDataSourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Looking up wrapping token via %q", wrappingLookupPath)
+	resp, err := client.Logical().Write(wrappingLookupPath, map[string]interface{}{
+		"token": d.Get("token").(string),
+	})
+	if err != nil {
+		if isWrappingTokenInvalidErr(err) {
+			return diag.Errorf("the wrapping token is either invalid, expired or has already been unwrapped: %s", err)
+		}
+		return diag.Errorf("error looking up wrapping token via %q: %s", wrappingLookupPath, err)
+	}
+	log.Printf("[DEBUG] Looked up wrapping token via %q", wrappingLookupPath)
+
+	if resp == nil || resp.Data == nil {
+		return diag.Errorf("no wrapping token info returned from %q", wrappingLookupPath)
+	}
+
+	var ttl int64
+	if v, ok := resp.Data["creation_ttl"].(json.Number); ok {
+		ttl, err = v.Int64()
+		if err != nil {
+			return diag.Errorf("unexpected creation_ttl in response from %q: %s", wrappingLookupPath, err)
+		}
+	}
+	if err := d.Set("creation_ttl", ttl); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range []string{"creation_time", "creation_path"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	d.SetId(wrappingLookupPath)
+
+	return nil
+}
+
+// isWrappingTokenInvalidErr returns true if Vault rejected the wrapping token,
+// which is the case once it has been unwrapped or has expired.
+func isWrappingTokenInvalidErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "wrapping token is not valid or does not exist")
+}
@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestDataSourceWrappingLookup(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	resourceName := "data.vault_wrapping_lookup.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceWrappingLookupConfig(backend, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "creation_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "creation_path",
+						fmt.Sprintf("auth/%s/role/%s/secret-id", backend, role)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceWrappingLookupConfig(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "%s"
+}
+
+resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
+  backend      = vault_auth_backend.approle.path
+  role_name    = vault_approle_auth_backend_role.role.role_name
+  wrapping_ttl = "10m"
+}
+
+data "vault_wrapping_lookup" "test" {
+  token = vault_approle_auth_backend_role_secret_id.secret_id.wrapping_token
+}
+`, backend, role)
+}
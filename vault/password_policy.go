@@ -72,6 +72,11 @@ func passwordPolicyRead(attributes []string, d *schema.ResourceData, meta interf
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
+	if policy == nil {
+		log.Printf("[WARN] %s password policy not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
 
 	for _, value := range attributes {
 		d.Set(value, policy[value])
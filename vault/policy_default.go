@@ -0,0 +1,10 @@
+package vault
+
+const (
+	// vaultDefaultPolicyName is the name of Vault's built-in default policy,
+	// it can be modified but never deleted.
+	vaultDefaultPolicyName = "default"
+	// vaultRootPolicyName is the name of Vault's built-in root policy,
+	// it can be neither modified nor deleted.
+	vaultRootPolicyName = "root"
+)
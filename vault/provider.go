@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/helper"
@@ -76,10 +77,25 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_SKIP_CHILD_TOKEN", false),
 
-				// Setting to true will cause max_lease_ttl_seconds and token_name to be ignored (not used).
+				// Setting to true will cause max_lease_ttl_seconds, token_ttl, token_max_ttl and token_name to be ignored (not used).
 				// Note that this is strongly discouraged due to the potential of exposing sensitive secret data.
 				Description: "Set this to true to prevent the creation of ephemeral child token used by this provider.",
 			},
+			"token_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TERRAFORM_VAULT_TOKEN_TTL", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "TTL in seconds of the Vault child token, defaults to max_lease_ttl_seconds.",
+			},
+			"token_max_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TERRAFORM_VAULT_TOKEN_MAX_TTL", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "Explicit max TTL in seconds of the Vault child token, defaults to its TTL. " +
+					"The child token is renewable when this is greater than its TTL.",
+			},
 			"ca_cert_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -93,9 +109,10 @@ func Provider() *schema.Provider {
 				Description: "Path to directory containing CA certificate files to validate the server's certificate.",
 			},
 			"auth_login": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Login to vault with an existing auth method using auth/<mount>/login",
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Login to vault with an existing auth method using auth/<mount>/login, " +
+					"multiple blocks are attempted in order until one succeeds.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						consts.FieldPath: {
@@ -117,9 +134,108 @@ func Provider() *schema.Provider {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						consts.FieldMFAMethodID: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "The ID or name of the MFA method to validate the login with, " +
+								"sent in the X-Vault-MFA header.",
+						},
+						consts.FieldMFAPasscode: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							DefaultFunc: schema.EnvDefaultFunc(consts.EnvVarMFAPasscode, ""),
+							Description: "The passcode used to satisfy the MFA requirement of the login, e.g. a TOTP code.",
+						},
+					},
+				},
+			},
+			"auth_login_cert": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Login to vault using the TLS certificate auth method.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldMount: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "cert",
+							Description: "The path where the cert auth method is mounted.",
+						},
+						consts.FieldName: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the certificate role to authenticate against.",
+						},
+						"cert_file": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path to a file containing the client certificate.",
+						},
+						"key_file": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path to a file containing the private key that the certificate was issued for.",
+						},
+						consts.FieldNamespace: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The namespace in which the cert auth method is mounted.",
+						},
+					},
+				},
+			},
+			"auth_login_oci": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Login to vault using the OCI auth method.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldMount: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "oci",
+							Description: "The path where the OCI auth method is mounted.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the OCI role to authenticate against.",
+						},
+						"auth_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The OCI credentials used to sign the login request, either apikey or instance.",
+							ValidateFunc: validation.StringInSlice([]string{"apikey", "instance"}, false),
+						},
+						"config_file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Path to the OCI config file holding the API key, defaults to ~/.oci/config.",
+						},
+						"profile": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "DEFAULT",
+							Description: "The profile of the OCI config file to use.",
+						},
+						consts.FieldNamespace: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The namespace in which the OCI auth method is mounted.",
+						},
 					},
 				},
 			},
+			"auth_login_fallback_to_token": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Use the configured token if none of the auth_login blocks succeed, " +
+					"instead of failing.",
+			},
 			"client_auth": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -176,6 +292,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_MAX_RETRIES_CCC", DefaultMaxHTTPRetriesCCC),
 				Description: "Maximum number of retries for Client Controlled Consistency related operations",
 			},
+			"skip_read_your_writes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_SKIP_READ_YOUR_WRITES", false),
+				Description: "Set this to true to disable the read-after-write consistency handling for Vault Enterprise performance standbys.",
+			},
 			consts.FieldNamespace: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -203,9 +325,9 @@ func Provider() *schema.Provider {
 				},
 			},
 		},
-		ConfigureFunc:  provider.NewProviderMeta,
-		DataSourcesMap: dataSourcesMap,
-		ResourcesMap:   resourcesMap,
+		ConfigureContextFunc: provider.NewProviderMetaContext,
+		DataSourcesMap:       dataSourcesMap,
+		ResourcesMap:         resourcesMap,
 	}
 }
 
@@ -232,6 +354,18 @@ type Description struct {
 
 var (
 	DataSourceRegistry = map[string]*Description{
+		"vault_pki_crl": {
+			Resource:      updateSchemaResource(pkiCRLDataSource()),
+			PathInventory: []string{"/pki/crl/pem"},
+		},
+		"vault_pki_ca_chain": {
+			Resource:      updateSchemaResource(pkiCAChainDataSource()),
+			PathInventory: []string{"/pki/cert/ca_chain"},
+		},
+		"vault_pki_secret_backend_cert": {
+			Resource:      updateSchemaResource(pkiSecretBackendCertDataSource()),
+			PathInventory: []string{"/pki/cert/{serial}"},
+		},
 		"vault_approle_auth_backend_role_id": {
 			Resource:      updateSchemaResource(approleAuthBackendRoleIDDataSource()),
 			PathInventory: []string{"/auth/approle/role/{role_name}/role-id"},
@@ -264,6 +398,10 @@ var (
 			Resource:      updateSchemaResource(awsAccessCredentialsDataSource()),
 			PathInventory: []string{"/aws/creds"},
 		},
+		"vault_aws_auth_backend_sts_roles": {
+			Resource:      updateSchemaResource(awsAuthBackendSTSRolesDataSource()),
+			PathInventory: []string{"/auth/aws/config/sts/?list=true"},
+		},
 		"vault_azure_access_credentials": {
 			Resource:      updateSchemaResource(azureAccessCredentialsDataSource()),
 			PathInventory: []string{"/azure/creds/{role}"},
@@ -280,6 +418,17 @@ var (
 			Resource:      updateSchemaResource(authBackendDataSource()),
 			PathInventory: []string{"/sys/auth"},
 		},
+		"vault_metrics": {
+			Resource:      updateSchemaResource(metricsDataSource()),
+			PathInventory: []string{"/sys/metrics"},
+		},
+		"vault_mount": {
+			Resource: updateSchemaResource(mountDataSource()),
+			PathInventory: []string{
+				"/sys/internal/ui/mounts/{path}",
+				"/sys/mounts/{path}/tune",
+			},
+		},
 		"vault_transit_encrypt": {
 			Resource:      updateSchemaResource(transitEncryptDataSource()),
 			PathInventory: []string{"/transit/encrypt/{name}"},
@@ -288,17 +437,77 @@ var (
 			Resource:      updateSchemaResource(transitDecryptDataSource()),
 			PathInventory: []string{"/transit/decrypt/{name}"},
 		},
+		"vault_transit_datakey": {
+			Resource:      updateSchemaResource(transitDataKeyDataSource()),
+			PathInventory: []string{"/transit/datakey/{plaintext}/{name}"},
+		},
+		"vault_transit_sign": {
+			Resource:      updateSchemaResource(transitSignDataSource()),
+			PathInventory: []string{"/transit/sign/{name}"},
+		},
+		"vault_transit_verify": {
+			Resource:      updateSchemaResource(transitVerifyDataSource()),
+			PathInventory: []string{"/transit/verify/{name}"},
+		},
+		"vault_transit_backup": {
+			Resource:      updateSchemaResource(transitBackupDataSource()),
+			PathInventory: []string{"/transit/backup/{name}"},
+		},
+		"vault_transit_secret_backend_key": {
+			Resource:      updateSchemaResource(transitSecretBackendKeyDataSource()),
+			PathInventory: []string{"/transit/keys/{name}"},
+		},
+		"vault_transit_wrapping_key": {
+			Resource:      updateSchemaResource(transitWrappingKeyDataSource()),
+			PathInventory: []string{"/transit/wrapping_key"},
+		},
+		"vault_password_policy_generate": {
+			Resource:      updateSchemaResource(passwordPolicyGenerateDataSource()),
+			PathInventory: []string{"/sys/policies/password/{name}/generate"},
+		},
+		"vault_resultant_acl": {
+			Resource:      updateSchemaResource(resultantACLDataSource()),
+			PathInventory: []string{"/sys/internal/ui/resultant-acl"},
+		},
+		"vault_token_info": {
+			Resource:      updateSchemaResource(tokenInfoDataSource()),
+			PathInventory: []string{"/auth/token/lookup-self"},
+		},
+		"vault_wrapping_lookup": {
+			Resource:      updateSchemaResource(wrappingLookupDataSource()),
+			PathInventory: []string{"/sys/wrapping/lookup"},
+		},
+		"vault_unwrap": {
+			Resource:      updateSchemaResource(unwrapDataSource()),
+			PathInventory: []string{"/sys/wrapping/unwrap"},
+		},
+		"vault_usage_counters": {
+			Resource:      updateSchemaResource(usageCountersDataSource()),
+			PathInventory: []string{"/sys/internal/counters/activity"},
+		},
+		"vault_version": {
+			Resource:      updateSchemaResource(versionDataSource()),
+			PathInventory: []string{"/sys/seal-status"},
+		},
+		"vault_version_history": {
+			Resource:      updateSchemaResource(versionHistoryDataSource()),
+			PathInventory: []string{"/sys/version-history"},
+		},
 		"vault_gcp_auth_backend_role": {
 			Resource:      updateSchemaResource(gcpAuthBackendRoleDataSource()),
 			PathInventory: []string{"/auth/gcp/role/{role_name}"},
 		},
+		"vault_identity_oidc_client": {
+			Resource:      updateSchemaResource(identityOIDCClientDataSource()),
+			PathInventory: []string{"/identity/oidc/client/{name}"},
+		},
 		"vault_identity_oidc_client_creds": {
 			Resource:      updateSchemaResource(identityOIDCClientCredsDataSource()),
 			PathInventory: []string{"/identity/oidc/client/{name}"},
 		},
 		"vault_identity_oidc_public_keys": {
 			Resource:      updateSchemaResource(identityOIDCPublicKeysDataSource()),
-			PathInventory: []string{"/identity/oidc/provider/{name}/.well-known/keys"},
+			PathInventory: []string{"/identity/oidc/provider/{name}/.well-known/keys", "/identity/oidc/.well-known/keys"},
 		},
 		"vault_identity_oidc_openid_config": {
 			Resource:      updateSchemaResource(identityOIDCOpenIDConfigDataSource()),
@@ -374,6 +583,10 @@ var (
 			Resource:      updateSchemaResource(adSecretBackendRoleResource()),
 			PathInventory: []string{"/ad/roles/{role}"},
 		},
+		"vault_aws_access_credentials": {
+			Resource:      updateSchemaResource(awsAccessCredentialsResource()),
+			PathInventory: []string{"/aws/creds/{name}", "/aws/sts/{name}"},
+		},
 		"vault_aws_auth_backend_cert": {
 			Resource:      updateSchemaResource(awsAuthBackendCertResource()),
 			PathInventory: []string{"/auth/aws/config/certificate/{cert_name}"},
@@ -450,6 +663,10 @@ var (
 			Resource:      updateSchemaResource(databaseSecretBackendRoleResource()),
 			PathInventory: []string{"/database/roles/{name}"},
 		},
+		"vault_database_secret_backend_root_rotation": {
+			Resource:      updateSchemaResource(databaseSecretBackendRootRotationResource()),
+			PathInventory: []string{"/database/rotate-root/{name}"},
+		},
 		"vault_database_secret_backend_static_role": {
 			Resource:      updateSchemaResource(databaseSecretBackendStaticRoleResource()),
 			PathInventory: []string{"/database/static-roles/{name}"},
@@ -526,6 +743,10 @@ var (
 			Resource:      updateSchemaResource(oktaAuthBackendGroupResource()),
 			PathInventory: []string{"/auth/okta/groups/{name}"},
 		},
+		"vault_lease": {
+			Resource:      updateSchemaResource(leaseResource()),
+			PathInventory: []string{"/sys/leases/lookup", "/sys/leases/renew", "/sys/leases/revoke"},
+		},
 		"vault_ldap_auth_backend": {
 			Resource:      updateSchemaResource(ldapAuthBackendResource()),
 			PathInventory: []string{"/auth/ldap/config"},
@@ -538,6 +759,10 @@ var (
 			Resource:      updateSchemaResource(ldapAuthBackendGroupResource()),
 			PathInventory: []string{"/auth/ldap/groups/{name}"},
 		},
+		"vault_ldap_secret_backend_dynamic_role": {
+			Resource:      updateSchemaResource(ldapSecretBackendDynamicRoleResource()),
+			PathInventory: []string{"/ldap/role/{name}"},
+		},
 		"vault_nomad_secret_backend": {
 			Resource: updateSchemaResource(nomadSecretAccessBackendResource()),
 			PathInventory: []string{
@@ -550,6 +775,10 @@ var (
 			Resource:      updateSchemaResource(nomadSecretBackendRoleResource()),
 			PathInventory: []string{"/nomad/role/{role}"},
 		},
+		"vault_plugin_reload": {
+			Resource:      updateSchemaResource(pluginReloadResource()),
+			PathInventory: []string{"/sys/plugins/reload/backend"},
+		},
 		"vault_policy": {
 			Resource:      updateSchemaResource(policyResource()),
 			PathInventory: []string{"/sys/policy/{name}"},
@@ -605,10 +834,18 @@ var (
 			Resource:      updateSchemaResource(sshSecretBackendRoleResource()),
 			PathInventory: []string{"/ssh/roles/{role}"},
 		},
+		"vault_ssh_secret_backend_zeroaddress": {
+			Resource:      updateSchemaResource(sshSecretBackendZeroAddressResource()),
+			PathInventory: []string{"/ssh/config/zeroaddress"},
+		},
 		"vault_identity_entity": {
 			Resource:      updateSchemaResource(identityEntityResource()),
 			PathInventory: []string{"/identity/entity"},
 		},
+		"vault_identity_entities": {
+			Resource:      updateSchemaResource(identityEntitiesResource()),
+			PathInventory: []string{"/identity/entity/name/{name}"},
+		},
 		"vault_identity_entity_alias": {
 			Resource:      updateSchemaResource(identityEntityAliasResource()),
 			PathInventory: []string{"/identity/entity-alias"},
@@ -629,10 +866,18 @@ var (
 			Resource:      updateSchemaResource(identityGroupMemberEntityIdsResource()),
 			PathInventory: []string{"/identity/group/id/{id}"},
 		},
+		"vault_identity_group_member_group_ids": {
+			Resource:      updateSchemaResource(identityGroupMemberGroupIdsResource()),
+			PathInventory: []string{"/identity/group/id/{id}"},
+		},
 		"vault_identity_group_policies": {
 			Resource:      updateSchemaResource(identityGroupPoliciesResource()),
 			PathInventory: []string{"/identity/lookup/group"},
 		},
+		"vault_identity_mfa_totp_admin_secret": {
+			Resource:      updateSchemaResource(identityMFATOTPAdminSecretResource()),
+			PathInventory: []string{"/identity/mfa/method/totp/admin-generate"},
+		},
 		"vault_identity_oidc": {
 			Resource:      updateSchemaResource(identityOidc()),
 			PathInventory: []string{"/identity/oidc/config"},
@@ -662,7 +907,7 @@ var (
 		},
 		"vault_password_policy": {
 			Resource:      updateSchemaResource(passwordPolicyResource()),
-			PathInventory: []string{"/sys/policy/password/{name}"},
+			PathInventory: []string{"/sys/policies/password/{name}"},
 		},
 		"vault_pki_secret_backend_cert": {
 			Resource:      updateSchemaResource(pkiSecretBackendCertResource()),
@@ -676,6 +921,19 @@ var (
 			Resource:      updateSchemaResource(pkiSecretBackendConfigCAResource()),
 			PathInventory: []string{"/pki/config/ca"},
 		},
+		"vault_pki_secret_backend_config_est": {
+			Resource:       updateSchemaResource(pkiSecretBackendConfigESTResource()),
+			PathInventory:  []string{"/pki/config/est"},
+			EnterpriseOnly: true,
+		},
+		"vault_pki_secret_backend_config_auto_tidy": {
+			Resource:      updateSchemaResource(pkiSecretBackendConfigAutoTidyResource()),
+			PathInventory: []string{"/pki/config/auto-tidy", "/pki/tidy"},
+		},
+		"vault_pki_secret_backend_config_issuers": {
+			Resource:      updateSchemaResource(pkiSecretBackendConfigIssuersResource()),
+			PathInventory: []string{"/pki/config/issuers"},
+		},
 		"vault_pki_secret_backend_config_urls": {
 			Resource:      updateSchemaResource(pkiSecretBackendConfigUrlsResource()),
 			PathInventory: []string{"/pki/config/urls"},
@@ -704,6 +962,10 @@ var (
 			Resource:      updateSchemaResource(pkiSecretBackendSignResource()),
 			PathInventory: []string{"/pki/sign/{role}"},
 		},
+		"vault_pki_secret_backend_sign_verbatim": {
+			Resource:      updateSchemaResource(pkiSecretBackendSignVerbatimResource()),
+			PathInventory: []string{"/pki/sign-verbatim", "/pki/sign-verbatim/{role}"},
+		},
 		"vault_quota_lease_count": {
 			Resource:      updateSchemaResource(quotaLeaseCountResource()),
 			PathInventory: []string{"/sys/quotas/lease-count/{name}"},
@@ -728,6 +990,10 @@ var (
 			Resource:      updateSchemaResource(transitSecretBackendKeyResource()),
 			PathInventory: []string{"/transit/keys/{name}"},
 		},
+		"vault_transit_secret_backend_key_restore": {
+			Resource:      updateSchemaResource(transitSecretBackendKeyRestoreResource()),
+			PathInventory: []string{"/transit/restore/{name}"},
+		},
 		"vault_transit_secret_cache_config": {
 			Resource:      updateSchemaResource(transitSecretBackendCacheConfig()),
 			PathInventory: []string{"/transit/cache-config"},
@@ -736,21 +1002,45 @@ var (
 			Resource:      updateSchemaResource(raftSnapshotAgentConfigResource()),
 			PathInventory: []string{"/sys/storage/raft/snapshot-auto/config/{name}"},
 		},
+		"vault_audit_request_header": {
+			Resource:      updateSchemaResource(auditRequestHeaderResource()),
+			PathInventory: []string{"/sys/config/auditing/request-headers/{header}"},
+		},
+		"vault_config_cors": {
+			Resource:      updateSchemaResource(configCORSResource()),
+			PathInventory: []string{"/sys/config/cors"},
+		},
+		"vault_config_ui_custom_message": {
+			Resource:       updateSchemaResource(configUICustomMessageResource()),
+			PathInventory:  []string{"/sys/config/ui/custom-messages/{id}"},
+			EnterpriseOnly: true,
+		},
+		"vault_rekey": {
+			Resource:      updateSchemaResource(rekeyResource()),
+			PathInventory: []string{"/sys/rekey/init", "/sys/rekey/update", "/sys/rekey/verify"},
+		},
+		"vault_root_token_generation": {
+			Resource:      updateSchemaResource(rootTokenGenerationResource()),
+			PathInventory: []string{"/sys/generate-root/attempt", "/sys/generate-root/update"},
+		},
 		"vault_raft_autopilot": {
 			Resource:      updateSchemaResource(raftAutopilotConfigResource()),
 			PathInventory: []string{"/sys/storage/raft/autopilot/configuration"},
 		},
 		"vault_kmip_secret_backend": {
-			Resource:      updateSchemaResource(kmipSecretBackendResource()),
-			PathInventory: []string{"/kmip/config"},
+			Resource:       updateSchemaResource(kmipSecretBackendResource()),
+			PathInventory:  []string{"/kmip/config"},
+			EnterpriseOnly: true,
 		},
 		"vault_kmip_secret_scope": {
-			Resource:      updateSchemaResource(kmipSecretScopeResource()),
-			PathInventory: []string{"/kmip/scope/{scope}"},
+			Resource:       updateSchemaResource(kmipSecretScopeResource()),
+			PathInventory:  []string{"/kmip/scope/{scope}"},
+			EnterpriseOnly: true,
 		},
 		"vault_kmip_secret_role": {
-			Resource:      updateSchemaResource(kmipSecretRoleResource()),
-			PathInventory: []string{"/kmip/scope/{scope}/role/{role}"},
+			Resource:       updateSchemaResource(kmipSecretRoleResource()),
+			PathInventory:  []string{"/kmip/scope/{scope}/role/{role}"},
+			EnterpriseOnly: true,
 		},
 		"vault_identity_oidc_scope": {
 			Resource:      updateSchemaResource(identityOIDCScopeResource()),
@@ -770,7 +1060,7 @@ var (
 		},
 		"vault_kv_secret_backend_v2": {
 			Resource:      updateSchemaResource(kvSecretBackendV2Resource()),
-			PathInventory: []string{"/secret/data/{path}"},
+			PathInventory: []string{"/secret/config"},
 		},
 		"vault_kv_secret": {
 			Resource:      updateSchemaResource(kvSecretResource("vault_kv_secret")),
@@ -780,6 +1070,18 @@ var (
 			Resource:      updateSchemaResource(kvSecretV2Resource("vault_kv_secret_v2")),
 			PathInventory: []string{"/secret/data/{path}"},
 		},
+		"vault_kv_secret_v2_versions": {
+			Resource: updateSchemaResource(kvSecretV2VersionsResource()),
+			PathInventory: []string{
+				"/secret/delete/{path}",
+				"/secret/undelete/{path}",
+				"/secret/destroy/{path}",
+			},
+		},
+		"vault_userpass_auth_backend_user": {
+			Resource:      updateSchemaResource(userpassAuthBackendUserResource()),
+			PathInventory: []string{"/auth/userpass/users/{username}"},
+		},
 	}
 )
 
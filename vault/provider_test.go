@@ -3,6 +3,8 @@ package vault
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"testing"
@@ -93,6 +95,89 @@ func TestAccAuthLoginProviderConfigure(t *testing.T) {
 	}
 }
 
+func TestAuthLoginFallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/approle/login":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errors": ["invalid role or secret ID"]}`)
+		case "/v1/auth/userpass/login/alice":
+			fmt.Fprint(w, `{"auth": {"client_token": "login-token"}}`)
+		default:
+			t.Errorf("unexpected request to %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	approleLogin := map[string]interface{}{
+		consts.FieldPath: "auth/approle/login",
+		consts.FieldParameters: map[string]interface{}{
+			"role_id":   "role",
+			"secret_id": "secret",
+		},
+	}
+	userpassLogin := map[string]interface{}{
+		consts.FieldPath: "auth/userpass/login/alice",
+		consts.FieldParameters: map[string]interface{}{
+			"password": "secret",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		authLogin []interface{}
+		fallback  bool
+		wantToken string
+		wantErr   bool
+	}{
+		{
+			name:      "second-succeeds",
+			authLogin: []interface{}{approleLogin, userpassLogin},
+			wantToken: "login-token",
+		},
+		{
+			name:      "first-succeeds",
+			authLogin: []interface{}{userpassLogin, approleLogin},
+			wantToken: "login-token",
+		},
+		{
+			name:      "all-fail",
+			authLogin: []interface{}{approleLogin},
+			wantErr:   true,
+		},
+		{
+			name:      "all-fail-fallback-to-token",
+			authLogin: []interface{}{approleLogin},
+			fallback:  true,
+			wantToken: "configured-token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+				"address":                      server.URL,
+				"token":                        "configured-token",
+				"skip_child_token":             true,
+				"auth_login":                   tt.authLogin,
+				"auth_login_fallback_to_token": tt.fallback,
+			})
+
+			meta, err := provider.NewProviderMeta(d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewProviderMeta() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := meta.(*provider.ProviderMeta).GetClient().Token(); got != tt.wantToken {
+				t.Errorf("NewProviderMeta() token = %q, want %q", got, tt.wantToken)
+			}
+		})
+	}
+}
+
 func TestTokenReadProviderConfigureWithHeaders(t *testing.T) {
 	rootProvider := Provider()
 
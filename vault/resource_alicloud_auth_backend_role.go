@@ -174,9 +174,7 @@ func alicloudAuthBackendRoleRead(_ context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
-
-	return diags
+	return nil
 }
 
 func alicloudAuthBackendRoleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
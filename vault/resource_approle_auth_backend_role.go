@@ -44,7 +44,8 @@ func approleAuthBackendRoleResource() *schema.Resource {
 			Optional:    true,
 			Description: "List of CIDR blocks that can log in using the AppRole.",
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCIDR,
 			},
 		},
 		"secret_id_num_uses": {
@@ -57,6 +58,12 @@ func approleAuthBackendRoleResource() *schema.Resource {
 			Optional:    true,
 			Description: "Number of seconds a SecretID remains valid for.",
 		},
+		"local_secret_ids": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "If true, the secret IDs generated using this role will be cluster local. This can only be set during role creation.",
+		},
 		"backend": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -103,6 +110,10 @@ func approleAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[string]
 		if v, ok := d.GetOk("secret_id_bound_cidrs"); ok {
 			data["secret_id_bound_cidrs"] = v.(*schema.Set).List()
 		}
+
+		if v, ok := d.GetOk("local_secret_ids"); ok {
+			data["local_secret_ids"] = v.(bool)
+		}
 	} else {
 		if d.HasChange("bind_secret_id") {
 			data["bind_secret_id"] = d.Get("bind_secret_id").(bool)
@@ -217,13 +228,17 @@ func approleAuthBackendRoleRead(_ context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	if v, ok := resp.Data["secret_id_bound_cidrs"]; ok {
+	if _, ok := resp.Data["secret_id_bound_cidrs"]; ok {
+		v, err := handleCIDRField(d, "secret_id_bound_cidrs", resp)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		if err := d.Set("secret_id_bound_cidrs", v); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	for _, k := range []string{"bind_secret_id", "secret_id_num_uses", "secret_id_ttl"} {
+	for _, k := range []string{"bind_secret_id", "secret_id_num_uses", "secret_id_ttl", "local_secret_ids"} {
 		if err := d.Set(k, resp.Data[k]); err != nil {
 			return diag.FromErr(err)
 		}
@@ -241,9 +256,7 @@ func approleAuthBackendRoleRead(_ context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
-
-	return diags
+	return nil
 }
 
 func approleAuthBackendRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -6,8 +6,10 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -32,12 +34,13 @@ func approleAuthBackendRoleSecretIDResource(name string) *schema.Resource {
 			},
 
 			"secret_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The SecretID to be managed. If not specified, Vault auto-generates one.",
-				ForceNew:    true,
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The SecretID to be managed. If not specified, Vault auto-generates one.",
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validateAppRoleSecretID,
 			},
 
 			"cidr_list": {
@@ -50,6 +53,33 @@ func approleAuthBackendRoleSecretIDResource(name string) *schema.Resource {
 				ForceNew: true,
 			},
 
+			"token_bound_cidrs": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of CIDR blocks that can use tokens generated by the SecretID.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDR,
+				},
+				ForceNew: true,
+			},
+
+			"num_uses": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Number of times the SecretID can be used to log in. A value of 0 allows unlimited uses.",
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Number of seconds after which the SecretID expires. If not set, the role's secret_id_ttl is used.",
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
 			consts.FieldMetadata: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -94,11 +124,12 @@ func approleAuthBackendRoleSecretIDResource(name string) *schema.Resource {
 			},
 
 			"wrapping_ttl": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The TTL duration of the wrapped SecretID.",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The TTL duration of the wrapped SecretID. If set, only the wrapping token is stored in the state.",
+				ValidateFunc: validateDurationSeconds,
 			},
 
 			"wrapping_token": {
@@ -133,18 +164,21 @@ func approleAuthBackendRoleSecretIDCreate(d *schema.ResourceData, meta interface
 	}
 
 	log.Printf("[DEBUG] Writing AppRole auth backend role SecretID %q", path)
-	iCIDRs := d.Get("cidr_list").(*schema.Set).List()
-	cidrs := make([]string, 0, len(iCIDRs))
-	for _, iCIDR := range iCIDRs {
-		cidrs = append(cidrs, iCIDR.(string))
-	}
 
 	data := map[string]interface{}{}
 	if v, ok := d.GetOk("secret_id"); ok {
 		data["secret_id"] = v.(string)
 	}
-	if len(cidrs) > 0 {
-		data["cidr_list"] = strings.Join(cidrs, ",")
+	for _, k := range []string{"cidr_list", "token_bound_cidrs"} {
+		if cidrs := util.TerraformSetToStringArray(d.Get(k)); len(cidrs) > 0 {
+			data[k] = strings.Join(cidrs, ",")
+		}
+	}
+	if v, ok := d.GetOk("num_uses"); ok {
+		data["num_uses"] = v.(int)
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
 	}
 	if v, ok := d.GetOk(consts.FieldMetadata); ok {
 		name := "vault_approle_auth_backend_role_secret_id"
@@ -249,23 +283,6 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 		return nil
 	}
 
-	var cidrs []string
-	switch data := resp.Data["cidr_list"].(type) {
-	case string:
-		if data != "" {
-			cidrs = strings.Split(data, ",")
-		}
-	case []interface{}:
-		cidrs = make([]string, 0, len(data))
-		for _, i := range data {
-			cidrs = append(cidrs, i.(string))
-		}
-	case nil:
-		cidrs = make([]string, 0)
-	default:
-		return fmt.Errorf("unknown type %T for cidr_list in response for SecretID %q", data, accessor)
-	}
-
 	metadata, err := json.Marshal(resp.Data["metadata"])
 	if err != nil {
 		return fmt.Errorf("error encoding metadata for SecretID %q to JSON: %s", id, err)
@@ -273,9 +290,22 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 
 	d.Set("backend", backend)
 	d.Set("role_name", role)
-	err = d.Set("cidr_list", cidrs)
-	if err != nil {
-		return fmt.Errorf("error setting cidr_list in state: %s", err)
+	// num_uses and ttl are not read back, since Vault reports the remaining
+	// uses and the absolute expiration time rather than the requested values.
+	for _, k := range []string{"cidr_list", "token_bound_cidrs"} {
+		cidrs, err := approleAuthBackendRoleSecretIDCIDRs(resp.Data[k])
+		if err != nil {
+			return fmt.Errorf("error reading %s in response for SecretID %q: %s", k, accessor, err)
+		}
+		if k == "token_bound_cidrs" {
+			cidrs, err = normalizeCIDRs(d.Get(k).(*schema.Set), cidrs)
+			if err != nil {
+				return fmt.Errorf("error reading %s in response for SecretID %q: %s", k, accessor, err)
+			}
+		}
+		if err := d.Set(k, cidrs); err != nil {
+			return fmt.Errorf("error setting %s in state: %s", k, err)
+		}
 	}
 	d.Set(consts.FieldMetadata, string(metadata))
 	d.Set("accessor", accessor)
@@ -360,6 +390,46 @@ func approleAuthBackendRoleSecretIDExists(d *schema.ResourceData, meta interface
 	return resp != nil, nil
 }
 
+// validateAppRoleSecretID ensures that a custom SecretID is non-empty and
+// contains no whitespace, since such a value could not be reliably presented
+// back to Vault on login.
+func validateAppRoleSecretID(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if v == "" {
+		return nil, []error{fmt.Errorf("expected %s to not be empty", k)}
+	}
+
+	if strings.IndexFunc(v, unicode.IsSpace) != -1 {
+		return nil, []error{fmt.Errorf("expected %s to not contain whitespace", k)}
+	}
+
+	return nil, nil
+}
+
+func approleAuthBackendRoleSecretIDCIDRs(v interface{}) ([]string, error) {
+	switch data := v.(type) {
+	case string:
+		if data != "" {
+			return strings.Split(data, ","), nil
+		}
+		return []string{}, nil
+	case []interface{}:
+		cidrs := make([]string, 0, len(data))
+		for _, i := range data {
+			cidrs = append(cidrs, i.(string))
+		}
+		return cidrs, nil
+	case nil:
+		return []string{}, nil
+	default:
+		return nil, fmt.Errorf("unknown type %T", data)
+	}
+}
+
 func approleAuthBackendRoleSecretIDID(backend, role, accessor string, wrapped bool, withWrappedAccessor bool) string {
 	if wrapped && !withWrappedAccessor {
 		accessor = "wrapped-" + accessor
@@ -55,6 +55,7 @@ func TestAccAppRoleAuthBackendRoleSecretID_wrapped(t *testing.T) {
 					resource.TestCheckResourceAttr(secretIDResource, "role_name", role),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_accessor"),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_token"),
+					resource.TestCheckResourceAttr(secretIDResource, "secret_id", ""),
 				),
 			},
 		},
@@ -114,6 +115,7 @@ func TestAccAppRoleAuthBackendRoleSecretID_wrapped_namespace(t *testing.T) {
 					resource.TestCheckResourceAttr(secretIDResource, "role_name", role),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_accessor"),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_token"),
+					resource.TestCheckResourceAttr(secretIDResource, "secret_id", ""),
 				),
 			},
 		},
@@ -174,9 +176,19 @@ func TestAccAppRoleAuthBackendRoleSecretID_full(t *testing.T) {
 					resource.TestCheckResourceAttr(secretIDResource, "secret_id", secretID),
 					resource.TestCheckResourceAttrSet(secretIDResource, "accessor"),
 					resource.TestCheckResourceAttr(secretIDResource, "cidr_list.#", "2"),
+					resource.TestCheckResourceAttr(secretIDResource, "token_bound_cidrs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(secretIDResource, "token_bound_cidrs.*", "10.148.0.0/20"),
+					resource.TestCheckTypeSetElemAttr(secretIDResource, "token_bound_cidrs.*", "10.150.2.1/32"),
+					resource.TestCheckResourceAttr(secretIDResource, "num_uses", "5"),
+					resource.TestCheckResourceAttr(secretIDResource, "ttl", "3600"),
 					resource.TestCheckResourceAttr(secretIDResource, consts.FieldMetadata, `{"hello":"world"}`),
 				),
 			},
+			{
+				// Vault returns the /32 address without its prefix.
+				Config:   testAccAppRoleAuthBackendRoleSecretIDConfig_full(backend, role, secretID),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -239,6 +251,9 @@ resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
   role_name = vault_approle_auth_backend_role.role.role_name
   backend = vault_auth_backend.approle.path
   cidr_list = ["10.148.0.0/20", "10.150.0.0/20"]
+  token_bound_cidrs = ["10.148.0.0/20", "10.150.2.1/32"]
+  num_uses = 5
+  ttl = 3600
   metadata = <<EOF
 {
   "hello": "world"
@@ -295,3 +310,44 @@ func testAssertClientNamespace(expectedNS string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func Test_validateAppRoleSecretID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			value: "0a7e5c3d-6c4b-4cb6-a0b4-2f0b05db2b8c",
+		},
+		{
+			name:    "empty",
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "whitespace",
+			value:   "foo bar",
+			wantErr: true,
+		},
+		{
+			name:    "trailing-newline",
+			value:   "foo\n",
+			wantErr: true,
+		},
+		{
+			name:    "not-a-string",
+			value:   1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateAppRoleSecretID(tt.value, "secret_id")
+			if tt.wantErr != (len(errs) > 0) {
+				t.Errorf("validateAppRoleSecretID() errs = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
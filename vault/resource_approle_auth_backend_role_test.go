@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -37,21 +38,19 @@ func TestAccAppRoleAuthBackendRole_import(t *testing.T) {
 					resource.TestCheckResourceAttr(resourcePath, "token_period", "0"),
 					resource.TestCheckResourceAttr(resourcePath, "bind_secret_id", "false"),
 					resource.TestCheckResourceAttr(resourcePath, "secret_id_bound_cidrs.#", "2"),
-					resource.TestCheckResourceAttr(resourcePath, "secret_id_bound_cidrs.0", "10.148.0.0/20"),
-					resource.TestCheckResourceAttr(resourcePath, "secret_id_bound_cidrs.1", "10.150.0.0/20"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "secret_id_bound_cidrs.*", "10.148.0.0/20"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "secret_id_bound_cidrs.*", "10.150.0.0/20"),
 					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.#", "4"),
-					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.0", "10.148.1.1/32"),
-					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.1", "10.150.0.0/20"),
-					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.2", "10.150.2.1"),
-					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.3", "::1/128"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "10.148.1.1/32"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "10.150.0.0/20"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "10.150.2.1/32"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "::1/128"),
 				),
 			},
 			{
 				ResourceName:      "vault_approle_auth_backend_role.role",
 				ImportState:       true,
 				ImportStateVerify: true,
-				// TODO: once we fully enforce that the values are in CIDR notation then we can drop this ignore.
-				ImportStateVerifyIgnore: []string{TokenFieldBoundCIDRs},
 			},
 		},
 	})
@@ -192,10 +191,10 @@ func TestAccAppRoleAuthBackendRole_full(t *testing.T) {
 					resource.TestCheckResourceAttr(resourcePath, "token_max_ttl", "7200"),
 					resource.TestCheckResourceAttr(resourcePath, "token_num_uses", "12"),
 					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.#", "4"),
-					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.0", "10.148.1.1/32"),
-					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.1", "10.150.0.0/20"),
-					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.2", "10.150.2.1"),
-					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.3", "::1/128"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "10.148.1.1/32"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "10.150.0.0/20"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "10.150.2.1/32"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "::1/128"),
 					resource.TestCheckResourceAttr(resourcePath, "secret_id_ttl", "600"),
 					resource.TestCheckResourceAttr(resourcePath, "secret_id_num_uses", "5"),
 					resource.TestCheckResourceAttr(resourcePath, "token_period", "0"),
@@ -309,6 +308,63 @@ func TestAccAppRoleAuthBackendRole_fullUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAppRoleAuthBackendRole_boundCIDRs(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	resourcePath := "vault_approle_auth_backend_role.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_boundCIDRs(backend, role,
+					`["10.148.0.0/20", "10.150.2.1/32", "2001:db8::/32"]`,
+					`["10.148.1.1/32", "::1/128"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "local_secret_ids", "true"),
+					resource.TestCheckResourceAttr(resourcePath, "secret_id_num_uses", "3"),
+					resource.TestCheckResourceAttr(resourcePath, "secret_id_ttl", "300"),
+					resource.TestCheckResourceAttr(resourcePath, "secret_id_bound_cidrs.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "secret_id_bound_cidrs.*", "10.148.0.0/20"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "secret_id_bound_cidrs.*", "10.150.2.1/32"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "secret_id_bound_cidrs.*", "2001:db8::/32"),
+					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "10.148.1.1/32"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "::1/128"),
+				),
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_boundCIDRs(backend, role,
+					`["10.152.0.0/20", "::1/128"]`,
+					`["10.148.0.0/20", "10.150.2.1/32", "2001:db8::1/128"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourcePath, "local_secret_ids", "true"),
+					resource.TestCheckResourceAttr(resourcePath, "secret_id_bound_cidrs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "secret_id_bound_cidrs.*", "10.152.0.0/20"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "secret_id_bound_cidrs.*", "::1/128"),
+					resource.TestCheckResourceAttr(resourcePath, "token_bound_cidrs.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "10.148.0.0/20"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "10.150.2.1/32"),
+					resource.TestCheckTypeSetElemAttr(resourcePath, "token_bound_cidrs.*", "2001:db8::1/128"),
+				),
+			},
+			{
+				ResourceName:      resourcePath,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_boundCIDRs(backend, role,
+					`["10.152.0.0/20", "10.150.2.1"]`,
+					`["10.148.0.0/20"]`),
+				ExpectError: regexp.MustCompile(`to be a valid CIDR block`),
+			},
+		},
+	})
+}
+
 func testAccCheckAppRoleAuthBackendRoleDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_approle_auth_backend_role" {
@@ -378,7 +434,7 @@ resource "vault_approle_auth_backend_role" "role" {
   token_num_uses = 12
   token_ttl = 3600
   token_max_ttl = 7200
-  token_bound_cidrs = ["10.148.1.1/32", "10.150.0.0/20", "10.150.2.1", "::1/128"]
+  token_bound_cidrs = ["10.148.1.1/32", "10.150.0.0/20", "10.150.2.1/32", "::1/128"]
 }
 `, backend, role, roleID)
 
@@ -406,3 +462,21 @@ resource "vault_approle_auth_backend_role" "role" {
   token_max_ttl = 10800
 }`, backend, role, roleID)
 }
+
+func testAccAppRoleAuthBackendRoleConfig_boundCIDRs(backend, role, secretIDCIDRs, tokenCIDRs string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend               = vault_auth_backend.approle.path
+  role_name             = "%s"
+  local_secret_ids      = true
+  secret_id_num_uses    = 3
+  secret_id_ttl         = 300
+  secret_id_bound_cidrs = %s
+  token_bound_cidrs     = %s
+}`, backend, role, secretIDCIDRs, tokenCIDRs)
+}
@@ -0,0 +1,148 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const auditRequestHeadersPath = "sys/config/auditing/request-headers"
+
+func auditRequestHeaderResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: auditRequestHeaderWrite,
+		UpdateContext: auditRequestHeaderWrite,
+		ReadContext:   auditRequestHeaderRead,
+		DeleteContext: auditRequestHeaderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the request header to audit.",
+			},
+			"hmac": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the value of the header is HMAC'd in the audit logs.",
+			},
+		},
+	}
+}
+
+func auditRequestHeaderPath(name string) string {
+	return auditRequestHeadersPath + "/" + name
+}
+
+func auditRequestHeaderWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	name := d.Get(consts.FieldName).(string)
+	path := auditRequestHeaderPath(name)
+
+	log.Printf("[DEBUG] Writing audit request header %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"hmac": d.Get("hmac").(bool),
+	}); err != nil {
+		return diag.Errorf("error writing audit request header %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote audit request header %q", path)
+
+	d.SetId(name)
+
+	return auditRequestHeaderRead(ctx, d, meta)
+}
+
+func auditRequestHeaderRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	name := d.Id()
+	headers, err := readAuditRequestHeaders(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Vault stores header names in lower case.
+	settings, ok := headers[strings.ToLower(name)]
+	if !ok {
+		log.Printf("[WARN] Audit request header %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(consts.FieldName, name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hmac", settings["hmac"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func auditRequestHeaderDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := auditRequestHeaderPath(d.Id())
+
+	log.Printf("[DEBUG] Deleting audit request header %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting audit request header %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted audit request header %q", path)
+
+	return nil
+}
+
+// readAuditRequestHeaders returns the settings of all audited request headers, keyed on the
+// lower cased header name. The listing is used since reading a single header that is not
+// configured results in an error response from Vault.
+func readAuditRequestHeaders(client *api.Client) (map[string]map[string]interface{}, error) {
+	log.Printf("[DEBUG] Reading audit request headers from %q", auditRequestHeadersPath)
+	resp, err := client.Logical().Read(auditRequestHeadersPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading audit request headers from %q: %w", auditRequestHeadersPath, err)
+	}
+	log.Printf("[DEBUG] Read audit request headers from %q", auditRequestHeadersPath)
+
+	result := map[string]map[string]interface{}{}
+	if resp == nil || resp.Data["headers"] == nil {
+		return result, nil
+	}
+
+	headers, ok := resp.Data["headers"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected headers in response from %q: %#v", auditRequestHeadersPath, resp.Data["headers"])
+	}
+	for k, v := range headers {
+		settings, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected settings for header %q in response from %q: %#v", k, auditRequestHeadersPath, v)
+		}
+		result[strings.ToLower(k)] = settings
+	}
+
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccAuditRequestHeader(t *testing.T) {
+	name := acctest.RandomWithPrefix("X-Test-Header")
+	resourceName := "vault_audit_request_header.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccAuditRequestHeaderCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuditRequestHeaderConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "hmac", "false"),
+				),
+			},
+			{
+				Config: testAccAuditRequestHeaderConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "hmac", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAuditRequestHeaderCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+	headers, err := readAuditRequestHeaders(client)
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_audit_request_header" {
+			continue
+		}
+		if _, ok := headers[strings.ToLower(rs.Primary.ID)]; ok {
+			return fmt.Errorf("audit request header %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAuditRequestHeaderConfig(name string, hmac bool) string {
+	return fmt.Sprintf(`
+resource "vault_audit_request_header" "test" {
+  name = %q
+  hmac = %t
+}
+`, name, hmac)
+}
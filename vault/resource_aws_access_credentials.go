@@ -0,0 +1,172 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func awsAccessCredentialsResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsAccessCredentialsResourceCreate,
+		Read:   awsAccessCredentialsResourceRead,
+		Delete: awsAccessCredentialsResourceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AWS Secret Backend to read credentials from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AWS Secret Role to read credentials from.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "creds",
+				Description: "Type of credentials to read. Must be either 'creds' for Access Key and Secret Key, or 'sts' for STS.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					value := v.(string)
+					if value != "sts" && value != "creds" {
+						errs = append(errs, fmt.Errorf("type must be creds or sts"))
+					}
+					return nil, errs
+				},
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ARN to use if multiple are available in the role. Required if the role has multiple ARNs.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Region the read credentials belong to.",
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "User specified Time-To-Live for the STS token. Uses the Role defined default_sts_ttl when not specified",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Description: "Arbitrary map of values that, when changed, will generate " +
+					"new credentials.",
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "AWS access key ID read from Vault.",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "AWS secret key read from Vault.",
+			},
+			"security_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "AWS security token read from Vault. (Only returned if type is 'sts').",
+			},
+			consts.FieldLeaseID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			consts.FieldLeaseDuration: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			consts.FieldLeaseRenewable: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func awsAccessCredentialsResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	secret, err := readAWSAccessCredentials(client, d)
+	if err != nil {
+		return err
+	}
+
+	return validateAWSAccessCredentials(secret, d.Get("type").(string), d.Get("region").(string))
+}
+
+func awsAccessCredentialsResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	leaseID := d.Get(consts.FieldLeaseID).(string)
+	if leaseID == "" {
+		log.Printf("[WARN] No lease found for AWS credentials %q, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := lookupLease(client, leaseID)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		log.Printf("[WARN] Lease %q for AWS credentials expired, removing from state", leaseID)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func awsAccessCredentialsResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	leaseID := d.Get(consts.FieldLeaseID).(string)
+	if leaseID == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Revoking lease %q", leaseID)
+	if err := client.Sys().Revoke(leaseID); err != nil {
+		return fmt.Errorf("error revoking lease %q: %s", leaseID, err)
+	}
+	log.Printf("[DEBUG] Revoked lease %q", leaseID)
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccAWSAccessCredentials_basic(t *testing.T) {
+	mountPath := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := testutil.GetTestAWSCreds(t)
+	region := testutil.GetTestAWSRegion(t)
+	resourceName := "vault_aws_access_credentials.test"
+
+	var leaseID string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccAWSAccessCredentialsCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAccessCredentialsConfig_basic(mountPath, accessKey, secretKey, region, "2022-06-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "access_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_key"),
+					resource.TestCheckResourceAttr(resourceName, "security_token", ""),
+					resource.TestCheckResourceAttr(resourceName, "type", "creds"),
+					resource.TestCheckResourceAttrSet(resourceName, consts.FieldLeaseID),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, consts.FieldLeaseID),
+					testAccAWSAccessCredentialsSaveLeaseID(resourceName, &leaseID),
+				),
+			},
+			{
+				// the credentials are kept until the triggers change.
+				Config:   testAccAWSAccessCredentialsConfig_basic(mountPath, accessKey, secretKey, region, "2022-06-01"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSAccessCredentialsConfig_basic(mountPath, accessKey, secretKey, region, "2022-07-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.issued_on", "2022-07-01"),
+					testAccAWSAccessCredentialsCheckLeaseChanged(resourceName, &leaseID),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSAccessCredentialsCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_aws_access_credentials" {
+			continue
+		}
+
+		_, err := client.Logical().Write("sys/leases/lookup", map[string]interface{}{
+			"lease_id": rs.Primary.Attributes[consts.FieldLeaseID],
+		})
+		if err == nil {
+			return fmt.Errorf("lease %q still exists", rs.Primary.Attributes[consts.FieldLeaseID])
+		}
+		if !strings.Contains(err.Error(), "invalid lease") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAccessCredentialsSaveLeaseID(resourceName string, leaseID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		*leaseID = rs.Primary.Attributes[consts.FieldLeaseID]
+		return nil
+	}
+}
+
+func testAccAWSAccessCredentialsCheckLeaseChanged(resourceName string, leaseID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if actual := rs.Primary.Attributes[consts.FieldLeaseID]; actual == *leaseID {
+			return fmt.Errorf("expected new credentials to be issued, lease %q is unchanged", actual)
+		}
+		return nil
+	}
+}
+
+func testAccAWSAccessCredentialsConfig_basic(mountPath, accessKey, secretKey, region, issuedOn string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "aws" {
+  path        = "%s"
+  description = "Obtain AWS credentials."
+  access_key  = "%s"
+  secret_key  = "%s"
+  region      = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "role" {
+  backend         = vault_aws_secret_backend.aws.path
+  name            = "test"
+  credential_type = "iam_user"
+  policy_document = "{\"Version\": \"2012-10-17\", \"Statement\": [{\"Effect\": \"Allow\", \"Action\": \"iam:*\", \"Resource\": \"*\"}]}"
+}
+
+resource "vault_aws_access_credentials" "test" {
+  backend = vault_aws_secret_backend.aws.path
+  role    = vault_aws_secret_backend_role.role.name
+  type    = "creds"
+  region  = vault_aws_secret_backend.aws.region
+
+  triggers = {
+    issued_on = "%s"
+  }
+}
+`, mountPath, accessKey, secretKey, region, issuedOn)
+}
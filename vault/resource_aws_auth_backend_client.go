@@ -7,10 +7,20 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
+// awsAuthBackendClientWIFFields are the config/client fields used to configure
+// plugin workload identity federation.
+var awsAuthBackendClientWIFFields = []string{
+	consts.FieldRoleArn,
+	consts.FieldIdentityTokenAudience,
+	consts.FieldIdentityTokenTTL,
+}
+
 func awsAuthBackendClientResource() *schema.Resource {
 	return &schema.Resource{
 		Create: awsAuthBackendWrite,
@@ -35,16 +45,39 @@ func awsAuthBackendClientResource() *schema.Resource {
 				},
 			},
 			"access_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "AWS Access key with permissions to query AWS APIs.",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "AWS Access key with permissions to query AWS APIs.",
+				Sensitive:     true,
+				ConflictsWith: []string{consts.FieldIdentityTokenAudience},
 			},
 			"secret_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "AWS Secret key with permissions to query AWS APIs.",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "AWS Secret key with permissions to query AWS APIs.",
+				Sensitive:     true,
+				ConflictsWith: []string{consts.FieldIdentityTokenAudience},
+			},
+			consts.FieldRoleArn: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ARN of the AWS role to assume with the plugin identity token.",
+				RequiredWith: []string{consts.FieldIdentityTokenAudience},
+				ValidateFunc: validateARN,
+			},
+			consts.FieldIdentityTokenAudience: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The audience claim value of the plugin identity token, used to authenticate to AWS with workload identity federation instead of static credentials.",
+				RequiredWith: []string{consts.FieldRoleArn},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			consts.FieldIdentityTokenTTL: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL of the generated plugin identity token in seconds.",
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"ec2_endpoint": {
 				Type:        schema.TypeString,
@@ -107,6 +140,12 @@ func awsAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 		data["secret_key"] = d.Get("secret_key").(string)
 	}
 
+	for _, k := range awsAuthBackendClientWIFFields {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+
 	// sts_endpoint and sts_region are required to be set together
 	if (stsEndpoint == "") != (stsRegion == "") {
 		return fmt.Errorf("both sts_endpoint and sts_region need to be set")
@@ -156,6 +195,13 @@ func awsAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("sts_endpoint", secret.Data["sts_endpoint"])
 	d.Set("sts_region", secret.Data["sts_region"])
 	d.Set("iam_server_id_header_value", secret.Data["iam_server_id_header_value"])
+	for _, k := range awsAuthBackendClientWIFFields {
+		if v, ok := secret.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
@@ -114,6 +115,48 @@ func TestAccAWSAuthBackendClientStsRegionNoEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccAWSAuthBackendClient_identityToken(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	resourceName := "vault_aws_auth_backend_client.client"
+	roleArn := "arn:aws:iam::123456789012:role/vault-wif"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAWSAuthBackendClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSAuthBackendClientConfig_identityToken(backend, "not-an-arn", "vault.example.com", 600),
+				ExpectError: regexp.MustCompile(`expected role_arn to be a valid ARN, got "not-an-arn"`),
+			},
+			{
+				Config:      testAccAWSAuthBackendClientConfig_identityToken(backend, roleArn, " ", 600),
+				ExpectError: regexp.MustCompile(`expected "identity_token_audience" to not be an empty string or whitespace`),
+			},
+			{
+				Config: testAccAWSAuthBackendClientConfig_identityToken(backend, roleArn, "vault.example.com", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoleArn, roleArn),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault.example.com"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "600"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendClientConfig_identityToken(backend, roleArn, "vault-updated.example.com", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoleArn, roleArn),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault-updated.example.com"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "1800"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAuthBackendClientDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_aws_auth_backend_client" {
@@ -283,3 +326,19 @@ resource "vault_aws_auth_backend_client" "client" {
   iam_server_id_header_value = "vault.test"
 }`, backend)
 }
+
+func testAccAWSAuthBackendClientConfig_identityToken(backend, roleArn, audience string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  path = "%s"
+  type = "aws"
+  description = "Test auth backend for AWS backend client config"
+}
+
+resource "vault_aws_auth_backend_client" "client" {
+  backend                 = vault_auth_backend.aws.path
+  role_arn                = "%s"
+  identity_token_audience = "%s"
+  identity_token_ttl      = %d
+}`, backend, roleArn, audience, ttl)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
@@ -116,9 +117,11 @@ func awsAuthBackendRoleResource() *schema.Resource {
 			},
 		},
 		"inferred_entity_type": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "The type of inferencing Vault should do.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The type of inferencing Vault should do. The only valid value is ec2_instance.",
+			ValidateFunc: validation.StringInSlice([]string{"ec2_instance"}, false),
+			RequiredWith: []string{"inferred_aws_region"},
 		},
 		"inferred_aws_region": {
 			Type:        schema.TypeString,
@@ -402,9 +405,7 @@ func awsAuthBackendRoleRead(_ context.Context, d *schema.ResourceData, meta inte
 	d.Set("allow_instance_migration", resp.Data["allow_instance_migration"])
 	d.Set("disallow_reauthentication", resp.Data["disallow_reauthentication"])
 
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
-
-	return diags
+	return nil
 }
 
 func awsAuthBackendRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
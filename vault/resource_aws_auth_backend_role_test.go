@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -105,6 +106,27 @@ func TestAccAWSAuthBackendRole_inferred(t *testing.T) {
 	})
 }
 
+func TestAccAWSAuthBackendRole_inferredInvalid(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAWSAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSAuthBackendRoleConfig_inferredEntityType(backend, role, "ec2", `inferred_aws_region = "us-east-1"`),
+				ExpectError: regexp.MustCompile(`expected inferred_entity_type to be one of \[ec2_instance\]`),
+			},
+			{
+				Config:      testAccAWSAuthBackendRoleConfig_inferredEntityType(backend, role, "ec2_instance", ""),
+				ExpectError: regexp.MustCompile(`inferred_aws_region,inferred_entity_type`),
+			},
+		},
+	})
+}
+
 func TestAccAWSAuthBackendRole_ec2(t *testing.T) {
 	backend := acctest.RandomWithPrefix("aws")
 	role := acctest.RandomWithPrefix("test-role")
@@ -315,6 +337,22 @@ resource "vault_aws_auth_backend_role" "role" {
 }`, backend, role)
 }
 
+func testAccAWSAuthBackendRoleConfig_inferredEntityType(backend, role, entityType, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  type = "aws"
+  path = "%s"
+}
+resource "vault_aws_auth_backend_role" "role" {
+  backend = vault_auth_backend.aws.path
+  role = "%s"
+  auth_type = "iam"
+  bound_iam_instance_profile_arns = ["arn:aws:iam::123456789012:instance-profile/Webserver"]
+  inferred_entity_type = "%s"
+  %s
+}`, backend, role, entityType, extra)
+}
+
 func testAccAWSAuthBackendRoleConfig_iam(backend, role string) string {
 	return fmt.Sprintf(`
 resource "vault_auth_backend" "aws" {
@@ -31,6 +31,7 @@ func awsAuthBackendSTSRoleResource() *schema.Resource {
 			"account_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "AWS account ID to be associated with STS role.",
 			},
 			"sts_role": {
@@ -38,6 +39,11 @@ func awsAuthBackendSTSRoleResource() *schema.Resource {
 				Required:    true,
 				Description: "AWS ARN for STS role to be assumed when interacting with the account specified.",
 			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "External ID expected by the STS role.",
+			},
 			"backend": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -61,14 +67,11 @@ func awsAuthBackendSTSRoleCreate(d *schema.ResourceData, meta interface{}) error
 
 	backend := d.Get("backend").(string)
 	accountID := d.Get("account_id").(string)
-	stsRole := d.Get("sts_role").(string)
 
 	path := awsAuthBackendSTSRolePath(backend, accountID)
 
 	log.Printf("[DEBUG] Writing STS role %q to AWS auth backend", path)
-	_, err := client.Logical().Write(path, map[string]interface{}{
-		"sts_role": stsRole,
-	})
+	_, err := client.Logical().Write(path, awsAuthBackendSTSRoleRequestData(d))
 
 	d.SetId(path)
 
@@ -114,24 +117,40 @@ func awsAuthBackendSTSRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("backend", backend)
 	d.Set("account_id", accountID)
 	d.Set("sts_role", resp.Data["sts_role"])
+	// external_id is only returned by Vault 1.13 and later.
+	if v, ok := resp.Data["external_id"]; ok {
+		d.Set("external_id", v)
+	}
 	return nil
 }
 
+func awsAuthBackendSTSRoleRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"sts_role": d.Get("sts_role").(string),
+	}
+	// an empty external_id is sent on update, so that it can be cleared.
+	if d.IsNewResource() {
+		if v, ok := d.GetOk("external_id"); ok {
+			data["external_id"] = v.(string)
+		}
+	} else if d.HasChange("external_id") {
+		data["external_id"] = d.Get("external_id").(string)
+	}
+	return data
+}
+
 func awsAuthBackendSTSRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
 		return e
 	}
 
-	stsRole := d.Get("sts_role").(string)
 	path := d.Id()
 
 	log.Printf("[DEBUG] Updating STS role %q in AWS auth backend", path)
-	_, err := client.Logical().Write(path, map[string]interface{}{
-		"sts_role": stsRole,
-	})
+	_, err := client.Logical().Write(path, awsAuthBackendSTSRoleRequestData(d))
 	if err != nil {
-		return fmt.Errorf("error updating STS role %q in AWS auth backend", path)
+		return fmt.Errorf("error updating STS role %q in AWS auth backend: %s", path, err)
 	}
 	log.Printf("[DEBUG] Updated STS role %q in AWS auth backend", path)
 
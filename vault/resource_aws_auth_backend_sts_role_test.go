@@ -57,6 +57,49 @@ func TestAccAWSAuthBackendSTSRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSAuthBackendSTSRole_externalID(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	accountID := strconv.Itoa(acctest.RandInt())
+	arn := acctest.RandomWithPrefix("arn:aws:iam::" + accountID + ":role/test-role")
+	resourceName := "vault_aws_auth_backend_sts_role.role"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAWSAuthBackendSTSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendSTSRoleConfig_externalID(backend, accountID, arn, "external-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendSTSRoleCheck_attrs(backend, accountID, arn),
+					resource.TestCheckResourceAttr(resourceName, "external_id", "external-1"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendSTSRoleConfig_externalID(backend, accountID, arn, "external-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendSTSRoleCheck_attrs(backend, accountID, arn),
+					resource.TestCheckResourceAttr(resourceName, "external_id", "external-2"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendSTSRoleConfig_basic(backend, accountID, arn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendSTSRoleCheck_attrs(backend, accountID, arn),
+					resource.TestCheckResourceAttr(resourceName, "external_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAuthBackendSTSRoleDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_aws_auth_backend_sts_role" {
@@ -112,7 +155,8 @@ func testAccAWSAuthBackendSTSRoleCheck_attrs(backend, accountID, stsRole string)
 		}
 
 		attrs := map[string]string{
-			"sts_role": "sts_role",
+			"sts_role":    "sts_role",
+			"external_id": "external_id",
 		}
 		for stateAttr, apiAttr := range attrs {
 			if resp.Data[apiAttr] == nil && instanceState.Attributes[stateAttr] == "" {
@@ -140,3 +184,19 @@ resource "vault_aws_auth_backend_sts_role" "role" {
 }
 `, backend, accountID, stsRole)
 }
+
+func testAccAWSAuthBackendSTSRoleConfig_externalID(backend, accountID, stsRole, externalID string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  type = "aws"
+  path = "%s"
+}
+
+resource "vault_aws_auth_backend_sts_role" "role" {
+  backend     = vault_auth_backend.aws.path
+  account_id  = "%s"
+  sts_role    = "%s"
+  external_id = "%s"
+}
+`, backend, accountID, stsRole, externalID)
+}
@@ -6,12 +6,21 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
+// awsSecretBackendWIFFields are the config/root fields used to configure
+// plugin workload identity federation.
+var awsSecretBackendWIFFields = []string{
+	consts.FieldRoleArn,
+	consts.FieldIdentityTokenAudience,
+	consts.FieldIdentityTokenTTL,
+}
+
 func awsSecretBackendResource() *schema.Resource {
 	return &schema.Resource{
 		Create: awsSecretBackendCreate,
@@ -61,16 +70,39 @@ func awsSecretBackendResource() *schema.Resource {
 				Description: "Maximum possible lease duration for secrets in seconds",
 			},
 			"access_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The AWS Access Key ID to use when generating new credentials.",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The AWS Access Key ID to use when generating new credentials.",
+				Sensitive:     true,
+				ConflictsWith: []string{consts.FieldIdentityTokenAudience},
 			},
 			"secret_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The AWS Secret Access Key to use when generating new credentials.",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The AWS Secret Access Key to use when generating new credentials.",
+				Sensitive:     true,
+				ConflictsWith: []string{consts.FieldIdentityTokenAudience},
+			},
+			consts.FieldRoleArn: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ARN of the AWS role to assume with the plugin identity token.",
+				RequiredWith: []string{consts.FieldIdentityTokenAudience},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			consts.FieldIdentityTokenAudience: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The audience claim value of the plugin identity token.",
+				RequiredWith: []string{consts.FieldRoleArn},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			consts.FieldIdentityTokenTTL: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL of the generated plugin identity token in seconds.",
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"region": {
 				Type:        schema.TypeString,
@@ -148,6 +180,11 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	if usernameTemplate != "" {
 		data["username_template"] = usernameTemplate
 	}
+	for _, k := range awsSecretBackendWIFFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
 	_, err = client.Logical().Write(path+"/config/root", data)
 	if err != nil {
 		return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -224,6 +261,13 @@ func awsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := resp.Data["username_template"].(string); ok {
 			d.Set("username_template", v)
 		}
+		for _, k := range awsSecretBackendWIFFields {
+			if v, ok := resp.Data[k]; ok {
+				if err := d.Set(k, v); err != nil {
+					return err
+				}
+			}
+		}
 	}
 
 	d.Set(consts.FieldPath, path)
@@ -254,7 +298,7 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 		log.Printf("[DEBUG] Updated lease TTLs for %q", path)
 	}
-	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") {
+	if d.HasChanges(append([]string{"access_key", "secret_key", "region", "iam_endpoint", "sts_endpoint"}, awsSecretBackendWIFFields...)...) {
 		log.Printf("[DEBUG] Updating root credentials at %q", path+"/config/root")
 		data := map[string]interface{}{
 			"access_key": d.Get("access_key").(string),
@@ -277,6 +321,11 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		if usernameTemplate != "" {
 			data["username_template"] = usernameTemplate
 		}
+		for _, k := range awsSecretBackendWIFFields {
+			if d.HasChange(k) {
+				data[k] = d.Get(k)
+			}
+		}
 		_, err := client.Logical().Write(path+"/config/root", data)
 		if err != nil {
 			return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
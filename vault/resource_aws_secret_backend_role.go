@@ -1,17 +1,25 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
+// awsSecretBackendRoleAssumedRoleFields are only valid for the assumed_role credential type.
+var awsSecretBackendRoleAssumedRoleFields = []string{
+	"session_tags",
+	"external_id",
+}
+
 func awsSecretBackendRoleResource(name string) *schema.Resource {
 	return &schema.Resource{
 		Create: awsSecretBackendRoleWrite,
@@ -22,6 +30,7 @@ func awsSecretBackendRoleResource(name string) *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: awsSecretBackendRoleCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -41,7 +50,8 @@ func awsSecretBackendRoleResource(name string) *schema.Resource {
 				Optional:    true,
 				Description: "ARN for an existing IAM policy the role should use.",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateARN,
 				},
 			},
 			"policy_document": {
@@ -59,7 +69,8 @@ func awsSecretBackendRoleResource(name string) *schema.Resource {
 			"role_arns": {
 				Type: schema.TypeSet,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateARN,
 				},
 				Optional:    true,
 				ForceNew:    true,
@@ -86,19 +97,50 @@ func awsSecretBackendRoleResource(name string) *schema.Resource {
 				Description: "The max allowed TTL in seconds for STS credentials (credentials TTL are capped to max_sts_ttl). Valid only when credential_type is one of assumed_role or federation_token.",
 			},
 			"permissions_boundary_arn": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The ARN of the AWS Permissions Boundary to attach to IAM users created in the role. Valid only when credential_type is iam_user. If not specified, then no permissions boundary policy will be attached.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ARN of the AWS Permissions Boundary to attach to IAM users created in the role. Valid only when credential_type is iam_user. If not specified, then no permissions boundary policy will be attached.",
+				ValidateFunc: validateARN,
 			},
 			"user_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The path for the user name. Valid only when credential_type is iam_user. Default is /",
 			},
+			"session_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "The session tags to be set for assumed role credentials. Valid only when credential_type is assumed_role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"external_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The external ID to use when assuming the role. Valid only when credential_type is assumed_role.",
+				ValidateFunc: validation.StringLenBetween(2, 1224),
+			},
 		},
 	}
 }
 
+// awsSecretBackendRoleCustomizeDiff ensures that fields which are only valid
+// for the assumed_role credential type are rejected at plan time.
+func awsSecretBackendRoleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("credential_type") || d.Get("credential_type").(string) == "assumed_role" {
+		return nil
+	}
+
+	for _, k := range awsSecretBackendRoleAssumedRoleFields {
+		if _, ok := d.GetOk(k); ok {
+			return fmt.Errorf("%s is only valid when credential_type is assumed_role", k)
+		}
+	}
+
+	return nil
+}
+
 func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -160,6 +202,12 @@ func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	for _, k := range awsSecretBackendRoleAssumedRoleFields {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+
 	defaultStsTTL, defaultStsTTLOk := d.GetOk("default_sts_ttl")
 	maxStsTTL, maxStsTTLOk := d.GetOk("max_sts_ttl")
 	if credentialType == "assumed_role" || credentialType == "federation_token" {
@@ -242,6 +290,13 @@ func awsSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := secret.Data["user_path"]; ok {
 		d.Set("user_path", v)
 	}
+	for _, k := range awsSecretBackendRoleAssumedRoleFields {
+		if v, ok := secret.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
 
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
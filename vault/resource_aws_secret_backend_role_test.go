@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -114,6 +115,73 @@ func TestAccAWSSecretBackendRole_nested(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackendRole_sessionTags(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("tf-test-aws")
+	resourceName := "vault_aws_secret_backend_role.test"
+	accessKey, secretKey := testutil.GetTestAWSCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccAWSSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSecretBackendRoleConfig_sessionTags(name, backend, accessKey, secretKey, "foo", `{}`, "iam_user"),
+				ExpectError: regexp.MustCompile(`expected role_arns.\d+ to be a valid ARN`),
+			},
+			{
+				Config:      testAccAWSSecretBackendRoleConfig_sessionTags(name, backend, accessKey, secretKey, testAccAWSSecretBackendRoleRoleArn_basic, `{foo = "bar"}`, "iam_user"),
+				ExpectError: regexp.MustCompile(`session_tags is only valid when credential_type is assumed_role`),
+			},
+			{
+				Config: testAccAWSSecretBackendRoleConfig_sessionTags(name, backend, accessKey, secretKey, testAccAWSSecretBackendRoleRoleArn_basic, `{foo = "bar", baz = "qux"}`, "assumed_role"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "session_tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.baz", "qux"),
+					resource.TestCheckResourceAttr(resourceName, "external_id", "tf-test-external-id"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendRoleConfig_sessionTags(name, backend, accessKey, secretKey, testAccAWSSecretBackendRoleRoleArn_basic, `{foo = "baz"}`, "assumed_role"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "session_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.foo", "baz"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecretBackendRoleConfig_sessionTags(name, path, accessKey, secretKey, roleARN, sessionTags, credentialType string) string {
+	externalID := ""
+	if credentialType == "assumed_role" {
+		externalID = `external_id     = "tf-test-external-id"`
+	}
+
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path       = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "test" {
+  name            = "%s"
+  backend         = vault_aws_secret_backend.test.path
+  credential_type = "%s"
+  role_arns       = ["%s"]
+  session_tags    = %s
+  %s
+}
+`, path, accessKey, secretKey, name, credentialType, roleARN, sessionTags, externalID)
+}
+
 func testAccAWSSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_aws_secret_backend_role" {
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -103,6 +104,45 @@ func TestAccAWSSecretBackend_usernameTempl(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackend_identityToken(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-aws")
+	resourceType := "vault_aws_secret_backend"
+	resourceName := resourceType + ".test"
+	roleArn := "arn:aws:iam::123456789012:role/vault-wif"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testCheckMountDestroyed(resourceType, consts.MountTypeAWS, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSecretBackendConfig_identityTokenNoRole(path),
+				ExpectError: regexp.MustCompile(`"identity_token_audience": all of .+role_arn.+ must be specified`),
+			},
+			{
+				Config: testAccAWSSecretBackendConfig_identityToken(path, roleArn, "vault.example.com", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoleArn, roleArn),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault.example.com"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "600"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendConfig_identityToken(path, roleArn, "vault-updated.example.com", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoleArn, roleArn),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault-updated.example.com"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "1800"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAWSSecretBackendConfig_basic(path, accessKey, secretKey string) string {
 	return fmt.Sprintf(`
 resource "vault_aws_secret_backend" "test" {
@@ -154,3 +194,21 @@ resource "vault_aws_secret_backend" "test" {
   username_template = "%s"
 }`, path, accessKey, secretKey, templ)
 }
+
+func testAccAWSSecretBackendConfig_identityToken(path, roleArn, audience string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path                    = "%s"
+  role_arn                = "%s"
+  identity_token_audience = "%s"
+  identity_token_ttl      = %d
+}`, path, roleArn, audience, ttl)
+}
+
+func testAccAWSSecretBackendConfig_identityTokenNoRole(path string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path                    = "%s"
+  identity_token_audience = "vault.example.com"
+}`, path)
+}
@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
@@ -46,10 +48,24 @@ func azureAuthBackendConfigResource() *schema.Resource {
 				Sensitive:   true,
 			},
 			"client_secret": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The client secret for credentials to query the Azure APIs",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The client secret for credentials to query the Azure APIs",
+				Sensitive:     true,
+				ConflictsWith: []string{consts.FieldIdentityTokenAudience},
+			},
+			consts.FieldIdentityTokenAudience: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The audience claim value of the plugin identity token, used to authenticate to Azure with workload identity federation instead of a client secret.",
+				ConflictsWith: []string{"client_secret"},
+			},
+			consts.FieldIdentityTokenTTL: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL of the generated plugin identity token in seconds.",
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"resource": {
 				Type:        schema.TypeString,
@@ -66,7 +82,10 @@ func azureAuthBackendConfigResource() *schema.Resource {
 }
 
 func azureAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*provider.ProviderMeta).GetClient()
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
 
 	// if backend comes from the config, it won't have the StateFunc
 	// applied yet, so we need to apply it again.
@@ -87,8 +106,14 @@ func azureAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 		"environment":   environment,
 	}
 
+	for _, k := range []string{consts.FieldIdentityTokenAudience, consts.FieldIdentityTokenTTL} {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+
 	log.Printf("[DEBUG] Writing Azure auth backend config to %q", path)
-	_, err := config.Logical().Write(path, data)
+	_, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error writing to %q: %s", path, err)
 	}
@@ -100,10 +125,13 @@ func azureAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 }
 
 func azureAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*provider.ProviderMeta).GetClient()
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
 
 	log.Printf("[DEBUG] Reading Azure auth backend config")
-	secret, err := config.Logical().Read(d.Id())
+	secret, err := client.Logical().Read(d.Id())
 	if err != nil {
 		return fmt.Errorf("error reading Azure auth backend config from %q: %s", d.Id(), err)
 	}
@@ -126,14 +154,24 @@ func azureAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("resource", secret.Data["resource"])
 	d.Set("environment", secret.Data["environment"])
+	for _, k := range []string{consts.FieldIdentityTokenAudience, consts.FieldIdentityTokenTTL} {
+		if v, ok := secret.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
 func azureAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*provider.ProviderMeta).GetClient()
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
 
 	log.Printf("[DEBUG] Deleting Azure auth backend config from %q", d.Id())
-	_, err := config.Logical().Delete(d.Id())
+	_, err := client.Logical().Delete(d.Id())
 	if err != nil {
 		return fmt.Errorf("error deleting Azure auth backend config from %q: %s", d.Id(), err)
 	}
@@ -143,10 +181,13 @@ func azureAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func azureAuthBackendExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	config := meta.(*provider.ProviderMeta).GetClient()
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return false, e
+	}
 
 	log.Printf("[DEBUG] Checking if Azure auth backend is configured at %q", d.Id())
-	secret, err := config.Logical().Read(d.Id())
+	secret, err := client.Logical().Read(d.Id())
 	if err != nil {
 		return true, fmt.Errorf("error checking if Azure auth backend is configured at %q: %s", d.Id(), err)
 	}
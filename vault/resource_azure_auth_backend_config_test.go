@@ -52,6 +52,39 @@ func TestAccAzureAuthBackendConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureAuthBackendConfig_identityToken(t *testing.T) {
+	backend := acctest.RandomWithPrefix("azure")
+	resourceName := "vault_azure_auth_backend_config.config"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testAccCheckAzureAuthBackendConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureAuthBackendConfig_identityToken(backend, "vault.example.com", 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAzureAuthBackendConfigCheck_attrs(backend),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_audience", "vault.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_ttl", "600"),
+				),
+			},
+			{
+				Config: testAccAzureAuthBackendConfig_identityToken(backend, "vault-updated.example.com", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAzureAuthBackendConfigCheck_attrs(backend),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_audience", "vault-updated.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_ttl", "1800"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAzureAuthBackendConfigDestroy(s *terraform.State) error {
 	config := testProvider.Meta().(*provider.ProviderMeta).GetClient()
 
@@ -118,8 +151,9 @@ func testAccAzureAuthBackendConfigCheck_attrs(backend string) resource.TestCheck
 			"tenant_id": "tenant_id",
 			"client_id": "client_id",
 			//"client_secret":              "client_secret",
-			"resource":    "resource",
-			"environment": "environment",
+			"resource":                "resource",
+			"environment":             "environment",
+			"identity_token_audience": "identity_token_audience",
 		}
 		for stateAttr, apiAttr := range attrs {
 			if resp.Data[apiAttr] == nil && instanceState.Attributes[stateAttr] == "" {
@@ -149,3 +183,20 @@ resource "vault_azure_auth_backend_config" "config" {
   resource = "http://vault.hashicorp.com"
 }`, backend)
 }
+
+func testAccAzureAuthBackendConfig_identityToken(backend, audience string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "azure" {
+  path = "%s"
+  type = "azure"
+}
+
+resource "vault_azure_auth_backend_config" "config" {
+  backend                 = vault_auth_backend.azure.path
+  tenant_id               = "11111111-2222-3333-4444-555555555555"
+  client_id               = "11111111-2222-3333-4444-555555555555"
+  resource                = "http://vault.hashicorp.com"
+  identity_token_audience = "%s"
+  identity_token_ttl      = %d
+}`, backend, audience, ttl)
+}
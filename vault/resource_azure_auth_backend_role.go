@@ -11,9 +11,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 var (
+	azureAuthBackendRoleBindFields = []string{
+		"bound_service_principal_ids",
+		"bound_group_ids",
+		"bound_locations",
+		"bound_subscription_ids",
+		"bound_resource_groups",
+		"bound_scale_sets",
+	}
+
 	azureAuthBackendRoleBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/role/.+$")
 	azureAuthBackendRoleNameFromPathRegex    = regexp.MustCompile("^auth/.+/role/(.+)$")
 )
@@ -27,7 +37,7 @@ func azureAuthBackendRoleResource() *schema.Resource {
 			ForceNew:    true,
 		},
 		"bound_service_principal_ids": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Optional:    true,
 			Description: "The list of Service Principal IDs that login is restricted to.",
 			Elem: &schema.Schema{
@@ -35,7 +45,7 @@ func azureAuthBackendRoleResource() *schema.Resource {
 			},
 		},
 		"bound_group_ids": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Optional:    true,
 			Description: "The list of group ids that login is restricted to.",
 			Elem: &schema.Schema{
@@ -43,7 +53,7 @@ func azureAuthBackendRoleResource() *schema.Resource {
 			},
 		},
 		"bound_locations": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Optional:    true,
 			Description: "The list of locations that login is restricted to.",
 			Elem: &schema.Schema{
@@ -51,7 +61,7 @@ func azureAuthBackendRoleResource() *schema.Resource {
 			},
 		},
 		"bound_subscription_ids": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Optional:    true,
 			Description: "The list of subscription IDs that login is restricted to.",
 			Elem: &schema.Schema{
@@ -59,7 +69,7 @@ func azureAuthBackendRoleResource() *schema.Resource {
 			},
 		},
 		"bound_resource_groups": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Optional:    true,
 			Description: "The list of resource groups that login is restricted to.",
 			Elem: &schema.Schema{
@@ -67,7 +77,7 @@ func azureAuthBackendRoleResource() *schema.Resource {
 			},
 		},
 		"bound_scale_sets": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Optional:    true,
 			Description: "The list of scale set names that the login is restricted to.",
 			Elem: &schema.Schema{
@@ -117,58 +127,10 @@ func azureAuthBackendRoleCreate(ctx context.Context, d *schema.ResourceData, met
 	data := map[string]interface{}{}
 	updateTokenFields(d, data, true)
 
-	if _, ok := d.GetOk("bound_service_principal_ids"); ok {
-		iSPI := d.Get("bound_service_principal_ids").([]interface{})
-		bound_service_principal_ids := make([]string, len(iSPI))
-		for i, iSP := range iSPI {
-			bound_service_principal_ids[i] = iSP.(string)
-		}
-		data["bound_service_principal_ids"] = bound_service_principal_ids
-	}
-
-	if _, ok := d.GetOk("bound_group_ids"); ok {
-		iGI := d.Get("bound_group_ids").([]interface{})
-		bound_group_ids := make([]string, len(iGI))
-		for i, iG := range iGI {
-			bound_group_ids[i] = iG.(string)
-		}
-		data["bound_group_ids"] = bound_group_ids
-	}
-
-	if _, ok := d.GetOk("bound_locations"); ok {
-		iLS := d.Get("bound_locations").([]interface{})
-		bound_locations := make([]string, len(iLS))
-		for i, iL := range iLS {
-			bound_locations[i] = iL.(string)
-		}
-		data["bound_locations"] = bound_locations
-	}
-
-	if _, ok := d.GetOk("bound_subscription_ids"); ok {
-		iSI := d.Get("bound_subscription_ids").([]interface{})
-		bound_subscription_ids := make([]string, len(iSI))
-		for i, iS := range iSI {
-			bound_subscription_ids[i] = iS.(string)
-		}
-		data["bound_subscription_ids"] = bound_subscription_ids
-	}
-
-	if _, ok := d.GetOk("bound_resource_groups"); ok {
-		iRGN := d.Get("bound_resource_groups").([]interface{})
-		bound_resource_groups := make([]string, len(iRGN))
-		for i, iRG := range iRGN {
-			bound_resource_groups[i] = iRG.(string)
-		}
-		data["bound_resource_groups"] = bound_resource_groups
-	}
-
-	if _, ok := d.GetOk("bound_scale_sets"); ok {
-		iSS := d.Get("bound_scale_sets").([]interface{})
-		bound_scale_sets := make([]string, len(iSS))
-		for i, iS := range iSS {
-			bound_scale_sets[i] = iS.(string)
+	for _, k := range azureAuthBackendRoleBindFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = util.TerraformSetToStringArray(v)
 		}
-		data["bound_scale_sets"] = bound_scale_sets
 	}
 
 	d.SetId(path)
@@ -216,16 +178,13 @@ func azureAuthBackendRoleRead(_ context.Context, d *schema.ResourceData, meta in
 
 	d.Set("backend", backend)
 	d.Set("role", role)
-	d.Set("bound_service_principal_ids", resp.Data["bound_service_principal_ids"])
-	d.Set("bound_group_ids", resp.Data["bound_group_ids"])
-	d.Set("bound_locations", resp.Data["bound_locations"])
-	d.Set("bound_subscription_ids", resp.Data["bound_subscription_ids"])
-	d.Set("bound_resource_groups", resp.Data["bound_resource_groups"])
-	d.Set("bound_scale_sets", resp.Data["bound_scale_sets"])
-
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
+	for _, k := range azureAuthBackendRoleBindFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
 
-	return diags
+	return nil
 }
 
 func azureAuthBackendRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -240,54 +199,12 @@ func azureAuthBackendRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 	data := map[string]interface{}{}
 	updateTokenFields(d, data, false)
 
-	if _, ok := d.GetOk("bound_service_principal_ids"); ok {
-		iSPI := d.Get("bound_service_principal_ids").([]interface{})
-		bound_service_principal_ids := make([]string, len(iSPI))
-		for i, iSP := range iSPI {
-			bound_service_principal_ids[i] = iSP.(string)
-		}
-		data["bound_service_principal_ids"] = bound_service_principal_ids
-	}
-	if _, ok := d.GetOk("bound_group_ids"); ok {
-		iGI := d.Get("bound_group_ids").([]interface{})
-		bound_group_ids := make([]string, len(iGI))
-		for i, iG := range iGI {
-			bound_group_ids[i] = iG.(string)
-		}
-		data["bound_group_ids"] = bound_group_ids
-	}
-	if _, ok := d.GetOk("bound_locations"); ok {
-		iLS := d.Get("bound_locations").([]interface{})
-		bound_locations := make([]string, len(iLS))
-		for i, iL := range iLS {
-			bound_locations[i] = iL.(string)
-		}
-		data["bound_locations"] = bound_locations
-	}
-	if _, ok := d.GetOk("bound_subscription_ids"); ok {
-		iSI := d.Get("bound_subscription_ids").([]interface{})
-		bound_subscription_ids := make([]string, len(iSI))
-		for i, iS := range iSI {
-			bound_subscription_ids[i] = iS.(string)
-		}
-		data["bound_subscription_ids"] = bound_subscription_ids
-	}
-	if _, ok := d.GetOk("bound_resource_groups"); ok {
-		iRGN := d.Get("bound_resource_groups").([]interface{})
-		bound_resource_groups := make([]string, len(iRGN))
-		for i, iRG := range iRGN {
-			bound_resource_groups[i] = iRG.(string)
-		}
-		data["bound_resource_groups"] = bound_resource_groups
-	}
-	if _, ok := d.GetOk("bound_scale_sets"); ok {
-		iSS := d.Get("bound_scale_sets").([]interface{})
-		bound_scale_sets := make([]string, len(iSS))
-		for i, iS := range iSS {
-			bound_scale_sets[i] = iS.(string)
+	for _, k := range azureAuthBackendRoleBindFields {
+		if d.HasChange(k) {
+			data[k] = util.TerraformSetToStringArray(d.Get(k))
 		}
-		data["bound_scale_sets"] = bound_scale_sets
 	}
+
 	log.Printf("[DEBUG] Updating role %q in Azure auth backend", path)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
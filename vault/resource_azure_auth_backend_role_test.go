@@ -63,6 +63,53 @@ func TestAzureAuthBackendRole(t *testing.T) {
 	})
 }
 
+func TestAzureAuthBackendRole_bindings(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-azure-backend")
+	name := acctest.RandomWithPrefix("tf-test-azure-role")
+
+	resourceName := "vault_azure_auth_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAzureAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureAuthBackendRoleConfig_bindings(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAzureAuthBackendRoleCheck_attrs(resourceName, backend, name),
+					resource.TestCheckResourceAttr(resourceName, "bound_service_principal_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "bound_service_principal_ids.*", "sp-1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "bound_service_principal_ids.*", "sp-2"),
+					resource.TestCheckResourceAttr(resourceName, "bound_group_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bound_locations.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "bound_locations.*", "east us"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "bound_locations.*", "west us"),
+					resource.TestCheckResourceAttr(resourceName, "bound_subscription_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bound_resource_groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bound_scale_sets.#", "1"),
+				),
+			},
+			{
+				Config: testAzureAuthBackendRoleUnset(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAzureAuthBackendRoleCheck_attrs(resourceName, backend, name),
+					resource.TestCheckResourceAttr(resourceName, "bound_service_principal_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "bound_group_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "bound_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bound_subscription_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "bound_resource_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bound_scale_sets.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAzureAuthBackendRoleDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_azure_auth_backend_role" {
@@ -141,6 +188,11 @@ func testAzureAuthBackendRoleCheck_attrs(resourceName, backend, name string) res
 			case TokenFieldPolicies:
 				ta.AsSet = true
 			}
+			for _, f := range azureAuthBackendRoleBindFields {
+				if k == f {
+					ta.AsSet = true
+				}
+			}
 
 			tAttrs = append(tAttrs, ta)
 		}
@@ -205,3 +257,24 @@ resource "vault_azure_auth_backend_role" "test" {
 }
 `, backend, name)
 }
+
+func testAzureAuthBackendRoleConfig_bindings(backend, name string) string {
+	return fmt.Sprintf(`
+
+resource "vault_auth_backend" "azure" {
+    path = "%s"
+    type = "azure"
+}
+
+resource "vault_azure_auth_backend_role" "test" {
+    backend                     = vault_auth_backend.azure.path
+    role                        = "%s"
+    bound_service_principal_ids = ["sp-1", "sp-2"]
+    bound_group_ids             = ["group-1", "group-2"]
+    bound_locations             = ["west us", "east us"]
+    bound_subscription_ids      = ["11111111-2222-3333-4444-555555555555"]
+    bound_resource_groups       = ["test", "prod"]
+    bound_scale_sets            = ["scale-set-1"]
+}
+`, backend, name)
+}
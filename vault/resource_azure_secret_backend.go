@@ -6,8 +6,10 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
@@ -70,10 +72,25 @@ func azureSecretBackendResource() *schema.Resource {
 				Sensitive:   true,
 			},
 			"client_secret": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The client secret for credentials to query the Azure APIs",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The client secret for credentials to query the Azure APIs",
+				Sensitive:     true,
+				ConflictsWith: []string{consts.FieldIdentityTokenAudience},
+			},
+			consts.FieldIdentityTokenAudience: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The audience claim value of the plugin identity token.",
+				ConflictsWith: []string{"client_secret"},
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+			},
+			consts.FieldIdentityTokenTTL: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL of the generated plugin identity token in seconds.",
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"environment": {
 				Type:        schema.TypeString,
@@ -150,7 +167,7 @@ func azureSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
 
-	for _, k := range []string{"client_id", "subscription_id", "tenant_id", "use_microsoft_graph_api"} {
+	for _, k := range []string{"client_id", "subscription_id", "tenant_id", "use_microsoft_graph_api", consts.FieldIdentityTokenAudience, consts.FieldIdentityTokenTTL} {
 		if v, ok := resp.Data[k]; ok {
 			if err := d.Set(k, v); err != nil {
 				return err
@@ -256,5 +273,13 @@ func azureSecretBackendRequestData(d *schema.ResourceData) map[string]interface{
 		}
 	}
 
+	// only send the identity token fields when configured, they are not
+	// supported by older Vault versions.
+	for _, k := range []string{consts.FieldIdentityTokenAudience, consts.FieldIdentityTokenTTL} {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+
 	return data
 }
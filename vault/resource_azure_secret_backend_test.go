@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -61,6 +62,36 @@ func TestAzureSecretBackend(t *testing.T) {
 	})
 }
 
+func TestAzureSecretBackend_identityToken(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-azure")
+	resourceName := "vault_azure_secret_backend.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testAccAzureSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAzureSecretBackend_identityTokenWithSecret(path),
+				ExpectError: regexp.MustCompile(`"identity_token_audience": conflicts with client_secret`),
+			},
+			{
+				Config: testAzureSecretBackend_identityToken(path, "vault.example.com", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault.example.com"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "600"),
+				),
+			},
+			{
+				Config: testAzureSecretBackend_identityToken(path, "vault-updated.example.com", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenAudience, "vault-updated.example.com"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldIdentityTokenTTL, "1800"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAzureSecretBackendCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_azure_secret_backend" {
@@ -125,3 +156,27 @@ func testAzureSecretBackend_updateSubscriptionID(path string) string {
 	 use_microsoft_graph_api = true
 	}`, path)
 }
+
+func testAzureSecretBackend_identityToken(path, audience string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_azure_secret_backend" "test" {
+  path                    = "%s"
+  subscription_id         = "11111111-2222-3333-4444-111111111111"
+  tenant_id               = "11111111-2222-3333-4444-222222222222"
+  client_id               = "11111111-2222-3333-4444-333333333333"
+  identity_token_audience = "%s"
+  identity_token_ttl      = %d
+}`, path, audience, ttl)
+}
+
+func testAzureSecretBackend_identityTokenWithSecret(path string) string {
+	return fmt.Sprintf(`
+resource "vault_azure_secret_backend" "test" {
+  path                    = "%s"
+  subscription_id         = "11111111-2222-3333-4444-111111111111"
+  tenant_id               = "11111111-2222-3333-4444-222222222222"
+  client_id               = "11111111-2222-3333-4444-333333333333"
+  client_secret           = "12345678901234567890"
+  identity_token_audience = "vault.example.com"
+}`, path)
+}
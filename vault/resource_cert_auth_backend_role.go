@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
@@ -94,6 +95,38 @@ func certAuthBackendRoleResource() *schema.Resource {
 			Optional: true,
 			Computed: true,
 		},
+		"ocsp_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "If enabled, validate certificates' revocation status using OCSP.",
+		},
+		"ocsp_ca_certificates": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Any additional CA certificates needed to verify OCSP responses. Provided as PEM data.",
+		},
+		"ocsp_servers_override": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			Optional: true,
+			Description: "A list of OCSP server addresses. If unset, the OCSP server is determined " +
+				"from the AuthorityInformationAccess extension on the certificate being inspected.",
+		},
+		"ocsp_fail_open": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "If true and an OCSP response cannot be fetched or is of an unknown status, " +
+				"the login will proceed as if the certificate has not been revoked.",
+		},
+		"ocsp_query_all_servers": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "If set to true, rather than accepting the first successful OCSP response, " +
+				"query all servers and consider the certificate valid only if all servers agree.",
+		},
 		"backend": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -118,6 +151,31 @@ func certAuthBackendRoleResource() *schema.Resource {
 	}
 }
 
+// certAuthOCSPFields are only supported by Vault 1.13 and later, so they are
+// only read back when present in the response.
+var certAuthOCSPFields = []string{
+	"ocsp_enabled",
+	"ocsp_ca_certificates",
+	"ocsp_servers_override",
+	"ocsp_fail_open",
+	"ocsp_query_all_servers",
+}
+
+func setCertAuthOCSPFields(d *schema.ResourceData, data map[string]interface{}) {
+	for _, k := range certAuthOCSPFields {
+		if !d.HasChange(k) {
+			continue
+		}
+
+		switch v := d.Get(k).(type) {
+		case *schema.Set:
+			data[k] = v.List()
+		default:
+			data[k] = v
+		}
+	}
+}
+
 func certCertResourcePath(backend, name string) string {
 	return "auth/" + strings.Trim(backend, "/") + "/certs/" + strings.Trim(name, "/")
 }
@@ -166,6 +224,8 @@ func certAuthResourceWrite(ctx context.Context, d *schema.ResourceData, meta int
 		data["display_name"] = v.(string)
 	}
 
+	setCertAuthOCSPFields(d, data)
+
 	log.Printf("[DEBUG] Writing %q to cert auth backend", path)
 	d.SetId(path)
 	_, err := client.Logical().Write(path, data)
@@ -218,6 +278,8 @@ func certAuthResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		data["display_name"] = v.(string)
 	}
 
+	setCertAuthOCSPFields(d, data)
+
 	log.Printf("[DEBUG] Updating %q in cert auth backend", path)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -314,9 +376,15 @@ func certAuthResourceRead(_ context.Context, d *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
+	for _, k := range certAuthOCSPFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
 
-	return diags
+	return nil
 }
 
 func certAuthResourceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -115,6 +115,43 @@ func TestCertAuthBackend(t *testing.T) {
 	})
 }
 
+func TestCertAuthBackend_OCSP(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-cert-auth")
+	name := acctest.RandomWithPrefix("tf-test-cert-name")
+
+	resourceName := "vault_cert_auth_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		Providers:    testProviders,
+		CheckDestroy: testCertAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCertAuthBackendConfig_ocsp(backend, name, testCertificate, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ocsp_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_fail_open", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_query_all_servers", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_servers_override.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ocsp_servers_override.*", "https://ocsp1.example.com"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ocsp_servers_override.*", "https://ocsp2.example.com"),
+				),
+			},
+			{
+				Config: testCertAuthBackendConfig_ocsp(backend, name, testCertificate, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ocsp_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_fail_open", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_query_all_servers", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_servers_override.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testCertAuthBackendDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_cert_auth_backend_role" {
@@ -250,3 +287,24 @@ __CERTIFICATE__
 
 	return config
 }
+
+func testCertAuthBackendConfig_ocsp(backend, name, certificate string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "cert" {
+    path = "%s"
+    type = "cert"
+}
+
+resource "vault_cert_auth_backend_role" "test" {
+    name                   = "%s"
+    certificate            = <<__CERTIFICATE__
+%s
+__CERTIFICATE__
+    backend                = vault_auth_backend.cert.path
+    ocsp_enabled           = %[4]t
+    ocsp_fail_open         = %[4]t
+    ocsp_query_all_servers = %[4]t
+    ocsp_servers_override  = ["https://ocsp1.example.com", "https://ocsp2.example.com"]
+}
+`, backend, name, certificate, enabled)
+}
@@ -0,0 +1,180 @@
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const configCORSPath = "sys/config/cors"
+
+// configCORSStdAllowedHeaders are always allowed by Vault when CORS is enabled,
+// they are part of every response from sys/config/cors.
+var configCORSStdAllowedHeaders = []string{
+	"Content-Type",
+	"X-Requested-With",
+	"X-Vault-AWS-IAM-Server-ID",
+	"X-Vault-MFA",
+	"X-Vault-No-Request-Forwarding",
+	"X-Vault-Wrap-Format",
+	"X-Vault-Wrap-TTL",
+	"X-Vault-Policy-Override",
+	"Authorization",
+	"X-Vault-Token",
+}
+
+func configCORSResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: configCORSWrite,
+		UpdateContext: configCORSWrite,
+		ReadContext:   configCORSRead,
+		DeleteContext: configCORSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether CORS is enabled.",
+			},
+			"allowed_origins": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The origins that are permitted to make cross-origin requests, use \"*\" to allow all origins.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_headers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: "Headers that are permitted to be on cross-origin requests, " +
+					"in addition to the headers Vault always permits.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func configCORSWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	if !d.Get("enabled").(bool) {
+		log.Printf("[DEBUG] Disabling CORS via %q", configCORSPath)
+		if _, err := client.Logical().Delete(configCORSPath); err != nil {
+			return diag.Errorf("error disabling CORS via %q: %s", configCORSPath, err)
+		}
+		log.Printf("[DEBUG] Disabled CORS via %q", configCORSPath)
+	} else {
+		origins := util.TerraformSetToStringArray(d.Get("allowed_origins"))
+		if len(origins) == 0 {
+			return diag.Errorf("allowed_origins must be set when CORS is enabled")
+		}
+
+		data := map[string]interface{}{
+			"allowed_origins": origins,
+			"allowed_headers": util.TerraformSetToStringArray(d.Get("allowed_headers")),
+		}
+
+		log.Printf("[DEBUG] Configuring CORS via %q", configCORSPath)
+		if _, err := client.Logical().Write(configCORSPath, data); err != nil {
+			return diag.Errorf("error configuring CORS via %q: %s", configCORSPath, err)
+		}
+		log.Printf("[DEBUG] Configured CORS via %q", configCORSPath)
+	}
+
+	d.SetId(configCORSPath)
+
+	return configCORSRead(ctx, d, meta)
+}
+
+func configCORSRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Reading CORS config from %q", configCORSPath)
+	resp, err := client.Logical().Read(configCORSPath)
+	if err != nil {
+		return diag.Errorf("error reading CORS config from %q: %s", configCORSPath, err)
+	}
+	log.Printf("[DEBUG] Read CORS config from %q", configCORSPath)
+
+	if resp == nil {
+		return diag.Errorf("no CORS config returned from %q", configCORSPath)
+	}
+
+	enabled, _ := resp.Data["enabled"].(bool)
+	if err := d.Set("enabled", enabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the origins and headers are cleared by Vault when CORS is disabled,
+	// keep the configured values in that case to avoid a perpetual diff.
+	if !enabled {
+		return nil
+	}
+
+	if err := d.Set("allowed_origins", resp.Data["allowed_origins"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var headers []string
+	if v, ok := resp.Data["allowed_headers"].([]interface{}); ok {
+		headers = configCORSCustomHeaders(v)
+	}
+	if err := d.Set("allowed_headers", headers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func configCORSDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Disabling CORS via %q", configCORSPath)
+	if _, err := client.Logical().Delete(configCORSPath); err != nil {
+		return diag.Errorf("error disabling CORS via %q: %s", configCORSPath, err)
+	}
+	log.Printf("[DEBUG] Disabled CORS via %q", configCORSPath)
+
+	return nil
+}
+
+// configCORSCustomHeaders returns the allowed headers excluding those that
+// Vault adds to every CORS config.
+func configCORSCustomHeaders(headers []interface{}) []string {
+	std := make(map[string]bool, len(configCORSStdAllowedHeaders))
+	for _, h := range configCORSStdAllowedHeaders {
+		std[h] = true
+	}
+
+	var result []string
+	for _, v := range headers {
+		h, ok := v.(string)
+		if !ok || std[h] {
+			continue
+		}
+		result = append(result, h)
+	}
+
+	return result
+}
@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccConfigCORS(t *testing.T) {
+	resourceName := "vault_config_cors.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccConfigCORSCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "vault_config_cors" "test" {
+  allowed_origins = ["https://example.com"]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.0", "https://example.com"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_headers.#", "0"),
+				),
+			},
+			{
+				Config: `
+resource "vault_config_cors" "test" {
+  allowed_origins = ["https://example.com", "https://vault.example.com"]
+  allowed_headers = ["X-Custom-Header"]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_headers.0", "X-Custom-Header"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: `
+resource "vault_config_cors" "test" {
+  enabled         = false
+  allowed_origins = ["https://example.com"]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigCORSCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+	resp, err := client.Logical().Read(configCORSPath)
+	if err != nil {
+		return err
+	}
+
+	if resp != nil && resp.Data["enabled"] == true {
+		return fmt.Errorf("CORS is still enabled")
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const (
+	uiCustomMessagesPath = "sys/config/ui/custom-messages"
+
+	uiCustomMessageTypeBanner = "banner"
+	uiCustomMessageTypeModal  = "modal"
+)
+
+func configUICustomMessageResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: configUICustomMessageCreate,
+		UpdateContext: configUICustomMessageUpdate,
+		ReadContext:   configUICustomMessageRead,
+		DeleteContext: configUICustomMessageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The title of the custom message.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The text of the custom message, " +
+					"it is base64 encoded by the provider before being sent to Vault.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     uiCustomMessageTypeBanner,
+				Description: "The display type of the custom message, either banner or modal.",
+				ValidateFunc: validation.StringInSlice([]string{
+					uiCustomMessageTypeBanner,
+					uiCustomMessageTypeModal,
+				}, false),
+			},
+			"authenticated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "If true, the message is displayed after users have logged in, " +
+					"otherwise it is displayed on the login page.",
+			},
+			"start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The time from which the message is displayed, in RFC3339 format.",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: configUICustomMessageTimeDiffSuppress,
+			},
+			"end_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The time after which the message is no longer displayed, in RFC3339 format.",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: configUICustomMessageTimeDiffSuppress,
+			},
+			"link": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A hyperlink to display with the message.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The text of the hyperlink.",
+						},
+						"href": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The target URL of the hyperlink.",
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+			"options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional display options for the message.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func configUICustomMessageRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"title":         d.Get("title").(string),
+		"message":       base64.StdEncoding.EncodeToString([]byte(d.Get("message").(string))),
+		"type":          d.Get("type").(string),
+		"authenticated": d.Get("authenticated").(bool),
+		"start_time":    d.Get("start_time").(string),
+		"end_time":      d.Get("end_time").(string),
+		"options":       d.Get("options").(map[string]interface{}),
+	}
+
+	// Vault expects the link as a map of its title to its target.
+	link := map[string]interface{}{}
+	if v, ok := d.GetOk("link"); ok {
+		l := v.([]interface{})[0].(map[string]interface{})
+		link[l["title"].(string)] = l["href"].(string)
+	}
+	data["link"] = link
+
+	return data
+}
+
+func configUICustomMessageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	log.Printf("[DEBUG] Creating UI custom message via %q", uiCustomMessagesPath)
+	resp, err := client.Logical().Write(uiCustomMessagesPath, configUICustomMessageRequestData(d))
+	if err != nil {
+		return diag.Errorf("error creating UI custom message via %q: %s", uiCustomMessagesPath, err)
+	}
+	log.Printf("[DEBUG] Created UI custom message via %q", uiCustomMessagesPath)
+
+	var id string
+	if resp != nil {
+		id, _ = resp.Data["id"].(string)
+	}
+	if id == "" {
+		return diag.Errorf("no ID returned for the UI custom message created via %q", uiCustomMessagesPath)
+	}
+
+	d.SetId(id)
+
+	return configUICustomMessageRead(ctx, d, meta)
+}
+
+func configUICustomMessageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := uiCustomMessagesPath + "/" + d.Id()
+	log.Printf("[DEBUG] Updating UI custom message %q", path)
+	if _, err := client.Logical().Write(path, configUICustomMessageRequestData(d)); err != nil {
+		return diag.Errorf("error updating UI custom message %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated UI custom message %q", path)
+
+	return configUICustomMessageRead(ctx, d, meta)
+}
+
+func configUICustomMessageRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := uiCustomMessagesPath + "/" + d.Id()
+	log.Printf("[DEBUG] Reading UI custom message %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] UI custom message %q not found, removing from state", path)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading UI custom message %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read UI custom message %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] UI custom message %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range []string{"title", "type", "authenticated", "start_time", "end_time", "options"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	// keep the message as is if Vault returned something that is not base64 encoded.
+	message, _ := resp.Data["message"].(string)
+	if b, err := base64.StdEncoding.DecodeString(message); err == nil {
+		message = string(b)
+	}
+	if err := d.Set("message", message); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var link []map[string]interface{}
+	if v, ok := resp.Data["link"].(map[string]interface{}); ok {
+		for title, href := range v {
+			link = append(link, map[string]interface{}{
+				"title": title,
+				"href":  href,
+			})
+		}
+	}
+	if err := d.Set("link", link); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func configUICustomMessageDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := uiCustomMessagesPath + "/" + d.Id()
+	log.Printf("[DEBUG] Deleting UI custom message %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting UI custom message %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted UI custom message %q", path)
+
+	return nil
+}
+
+// configUICustomMessageTimeDiffSuppress suppresses the diff between two RFC3339
+// times that are the same instant, since Vault returns them in UTC.
+func configUICustomMessageTimeDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
@@ -0,0 +1,152 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccConfigUICustomMessage(t *testing.T) {
+	resourceName := "vault_config_ui_custom_message.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		CheckDestroy: testAccConfigUICustomMessageCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigUICustomMessageConfig("Maintenance", "banner", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "Maintenance"),
+					resource.TestCheckResourceAttr(resourceName, "message", "Vault will be unavailable on Saturday."),
+					resource.TestCheckResourceAttr(resourceName, "type", "banner"),
+					resource.TestCheckResourceAttr(resourceName, "authenticated", "true"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "link.#", "0"),
+				),
+			},
+			{
+				Config: testAccConfigUICustomMessageConfig("Planned maintenance", "modal", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "Planned maintenance"),
+					resource.TestCheckResourceAttr(resourceName, "type", "modal"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", "2124-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "link.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.title", "Details"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.href", "https://status.example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: `
+resource "vault_config_ui_custom_message" "test" {
+  title      = "Maintenance"
+  message    = "Vault will be unavailable on Saturday."
+  type       = "popup"
+  start_time = "2024-01-01T00:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile(`expected type to be one of \[banner modal\]`),
+			},
+		},
+	})
+}
+
+func testAccConfigUICustomMessageCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_config_ui_custom_message" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		resp, err := client.Logical().Read(uiCustomMessagesPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("UI custom message %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccConfigUICustomMessageConfig(title, messageType string, withLink bool) string {
+	var extra string
+	if withLink {
+		extra = `
+  end_time = "2124-01-01T00:00:00Z"
+
+  link {
+    title = "Details"
+    href  = "https://status.example.com"
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "vault_config_ui_custom_message" "test" {
+  title      = "%s"
+  message    = "Vault will be unavailable on Saturday."
+  type       = "%s"
+  start_time = "2024-01-01T00:00:00Z"
+%s
+}
+`, title, messageType, extra)
+}
+
+func TestConfigUICustomMessageTimeDiffSuppress(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want bool
+	}{
+		{
+			name: "equal",
+			old:  "2024-01-01T00:00:00Z",
+			new:  "2024-01-01T00:00:00Z",
+			want: true,
+		},
+		{
+			name: "same-instant-offset",
+			old:  "2024-01-01T00:00:00Z",
+			new:  "2024-01-01T01:00:00+01:00",
+			want: true,
+		},
+		{
+			name: "different",
+			old:  "2024-01-01T00:00:00Z",
+			new:  "2024-01-01T01:00:00Z",
+			want: false,
+		},
+		{
+			name: "unset",
+			old:  "",
+			new:  "2024-01-01T00:00:00Z",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := configUICustomMessageTimeDiffSuppress("start_time", tt.old, tt.new, nil); got != tt.want {
+				t.Errorf("configUICustomMessageTimeDiffSuppress() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
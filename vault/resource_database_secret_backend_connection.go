@@ -5,16 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/template"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -228,11 +229,7 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 						Default:     false,
 						Description: "Whether to disable certificate verification",
 					},
-					"username_template": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Description: "Template describing how dynamic usernames are generated.",
-					},
+					"username_template": dbUsernameTemplateSchema(),
 				},
 			},
 			MaxItems:      1,
@@ -307,6 +304,7 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 						Default:     5,
 						Description: "The number of seconds to use as a connection timeout.",
 					},
+					"username_template": dbUsernameTemplateSchema(),
 				},
 			},
 			MaxItems:      1,
@@ -360,11 +358,7 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 						Optional:    true,
 						Description: "Required for Couchbase versions prior to 6.5.0. This is only used to verify vault's connection to the server.",
 					},
-					"username_template": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Description: "Template describing how dynamic usernames are generated.",
-					},
+					"username_template": dbUsernameTemplateSchema(),
 				},
 			},
 			MaxItems:      1,
@@ -430,11 +424,7 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 						Default:     5,
 						Description: "The number of seconds to use as a connection timeout.",
 					},
-					"username_template": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Description: "Template describing how dynamic usernames are generated.",
-					},
+					"username_template": dbUsernameTemplateSchema(),
 				},
 			},
 			MaxItems:      1,
@@ -472,6 +462,7 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 						Required:    true,
 						Description: "The Project ID the Database User should be created within.",
 					},
+					"username_template": dbUsernameTemplateSchema(),
 				},
 			},
 			MaxItems:      1,
@@ -506,43 +497,34 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 			ConflictsWith: util.CalculateConflictsWith(dbEngineMySQL.Name(), dbEngineTypes),
 		},
 		dbEngineMySQLRDS.name: {
-			Type:        typ,
-			Optional:    true,
-			Description: "Connection parameters for the mysql-rds-database-plugin plugin.",
-			Elem: connectionStringResource(&connectionStringConfig{
-				includeUserPass: true,
-			}),
+			Type:          typ,
+			Optional:      true,
+			Description:   "Connection parameters for the mysql-rds-database-plugin plugin.",
+			Elem:          mysqlConnectionStringResource(),
 			MaxItems:      1,
 			ConflictsWith: util.CalculateConflictsWith(dbEngineMySQLRDS.Name(), dbEngineTypes),
 		},
 		dbEngineMySQLAurora.name: {
-			Type:        typ,
-			Optional:    true,
-			Description: "Connection parameters for the mysql-aurora-database-plugin plugin.",
-			Elem: connectionStringResource(&connectionStringConfig{
-				includeUserPass: true,
-			}),
+			Type:          typ,
+			Optional:      true,
+			Description:   "Connection parameters for the mysql-aurora-database-plugin plugin.",
+			Elem:          mysqlConnectionStringResource(),
 			MaxItems:      1,
 			ConflictsWith: util.CalculateConflictsWith(dbEngineMySQLAurora.Name(), dbEngineTypes),
 		},
 		dbEngineMySQLLegacy.name: {
-			Type:        typ,
-			Optional:    true,
-			Description: "Connection parameters for the mysql-legacy-database-plugin plugin.",
-			Elem: connectionStringResource(&connectionStringConfig{
-				includeUserPass: true,
-			}),
+			Type:          typ,
+			Optional:      true,
+			Description:   "Connection parameters for the mysql-legacy-database-plugin plugin.",
+			Elem:          mysqlConnectionStringResource(),
 			MaxItems:      1,
 			ConflictsWith: util.CalculateConflictsWith(dbEngineMySQLLegacy.Name(), dbEngineTypes),
 		},
 		dbEnginePostgres.name: {
-			Type:        typ,
-			Optional:    true,
-			Description: "Connection parameters for the postgresql-database-plugin plugin.",
-			Elem: connectionStringResource(&connectionStringConfig{
-				includeUserPass:        true,
-				includeDisableEscaping: true,
-			}),
+			Type:          typ,
+			Optional:      true,
+			Description:   "Connection parameters for the postgresql-database-plugin plugin.",
+			Elem:          postgresConnectionStringResource(),
 			MaxItems:      1,
 			ConflictsWith: util.CalculateConflictsWith(dbEnginePostgres.Name(), dbEngineTypes),
 		},
@@ -582,6 +564,33 @@ func getDatabaseSchema(typ schema.ValueType) schemaMap {
 	return dbSchemaMap
 }
 
+func dbUsernameTemplateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Template describing how dynamic usernames are generated.",
+		ValidateFunc: validateDBUsernameTemplate,
+	}
+}
+
+// validateDBUsernameTemplate ensures the username template can be parsed
+// with the same template functions that are available in Vault.
+func validateDBUsernameTemplate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if v == "" {
+		return nil, nil
+	}
+
+	if _, err := template.NewTemplate(template.Template(v)); err != nil {
+		return nil, []error{fmt.Errorf("invalid %s %q: %w", k, v, err)}
+	}
+
+	return nil, nil
+}
+
 func databaseSecretBackendConnectionResource() *schema.Resource {
 	s := setCommonDatabaseSchema(getDatabaseSchema(schema.TypeList))
 	s["backend"] = &schema.Schema{
@@ -594,6 +603,12 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 			return strings.Trim(v.(string), "/")
 		},
 	}
+	s["force"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Force deletion even if there are roles referencing the connection.",
+	}
 
 	return &schema.Resource{
 		Create: databaseSecretBackendConnectionCreateOrUpdate,
@@ -617,20 +632,23 @@ func connectionStringResource(config *connectionStringConfig) *schema.Resource {
 				Description: "Connection string to use to connect to the database.",
 			},
 			"max_open_connections": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Maximum number of open connections to the database.",
-				Default:     2,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of open connections to the database.",
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"max_idle_connections": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Maximum number of idle connections to the database.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of idle connections to the database.",
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"max_connection_lifetime": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Maximum number of seconds a connection may be reused.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of seconds a connection may be reused.",
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 		},
 	}
@@ -649,11 +667,7 @@ func connectionStringResource(config *connectionStringConfig) *schema.Resource {
 	}
 
 	if !config.excludeUsernameTemplate {
-		res.Schema["username_template"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Username generation template.",
-		}
+		res.Schema["username_template"] = dbUsernameTemplateSchema()
 	}
 
 	if config.includeDisableEscaping {
@@ -685,6 +699,31 @@ func mysqlConnectionStringResource() *schema.Resource {
 	return r
 }
 
+func postgresConnectionStringResource() *schema.Resource {
+	r := connectionStringResource(&connectionStringConfig{
+		includeUserPass:        true,
+		includeDisableEscaping: true,
+	})
+	r.Schema["tls_ca"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "x509 CA file for validating the certificate presented by the PostgreSQL server. Must be PEM encoded.",
+	}
+	r.Schema["tls_certificate"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "x509 client certificate for connecting to the database. Must be PEM encoded.",
+	}
+	r.Schema["private_key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The private key for the x509 client certificate. Must be PEM encoded.",
+		Sensitive:   true,
+	}
+
+	return r
+}
+
 func mssqlConnectionStringResource() *schema.Resource {
 	r := connectionStringResource(&connectionStringConfig{
 		includeUserPass:        true,
@@ -776,9 +815,7 @@ func getDatabaseAPIDataForEngine(engine *dbEngine, idx int, d *schema.ResourceDa
 		if v, ok := d.GetOk(prefix + "username"); ok {
 			data["username"] = v.(string)
 		}
-		if v, ok := d.GetOk("cassandra.0.password"); ok {
-			data["password"] = v.(string)
-		}
+		setDatabaseConnectionPassword(d, prefix, data)
 		if v, ok := d.GetOkExists(prefix + "tls"); ok {
 			data["tls"] = v.(bool)
 		}
@@ -797,6 +834,9 @@ func getDatabaseAPIDataForEngine(engine *dbEngine, idx int, d *schema.ResourceDa
 		if v, ok := d.GetOkExists(prefix + "connect_timeout"); ok {
 			data["connect_timeout"] = v.(int)
 		}
+		if v, ok := d.GetOk(prefix + "username_template"); ok {
+			data["username_template"] = v.(string)
+		}
 	case dbEngineCouchbase:
 		setCouchbaseDatabaseConnectionData(d, prefix, data)
 	case dbEngineInfluxDB:
@@ -815,20 +855,23 @@ func getDatabaseAPIDataForEngine(engine *dbEngine, idx int, d *schema.ResourceDa
 		if v, ok := d.GetOk(prefix + "project_id"); ok {
 			data["project_id"] = v.(string)
 		}
+		if v, ok := d.GetOk(prefix + "username_template"); ok {
+			data["username_template"] = v.(string)
+		}
 	case dbEngineMSSQL:
 		setMSSQLDatabaseConnectionData(d, prefix, data)
 	case dbEngineMySQL:
 		setMySQLDatabaseConnectionData(d, prefix, data)
 	case dbEngineMySQLRDS:
-		setDatabaseConnectionDataWithUserPass(d, prefix, data)
+		setMySQLDatabaseConnectionData(d, prefix, data)
 	case dbEngineMySQLAurora:
-		setDatabaseConnectionDataWithUserPass(d, prefix, data)
+		setMySQLDatabaseConnectionData(d, prefix, data)
 	case dbEngineMySQLLegacy:
-		setDatabaseConnectionDataWithUserPass(d, prefix, data)
+		setMySQLDatabaseConnectionData(d, prefix, data)
 	case dbEngineOracle:
 		setDatabaseConnectionDataWithUserPass(d, prefix, data)
 	case dbEnginePostgres:
-		setDatabaseConnectionDataWithDisableEscaping(d, prefix, data)
+		setPostgresDatabaseConnectionData(d, prefix, data)
 	case dbEngineElasticSearch:
 		setElasticsearchDatabaseConnectionData(d, prefix, data)
 	case dbEngineSnowflake:
@@ -854,28 +897,24 @@ func getConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, res
 			result["connection_url"] = v.(string)
 		}
 	}
-	if v, ok := data["max_open_connections"]; ok {
-		n, err := v.(json.Number).Int64()
-		if err != nil {
-			log.Printf("[WARN] Non-number %s returned from Vault server: %s", v, err)
-		} else {
-			result["max_open_connections"] = n
-		}
-	}
-	if v, ok := data["max_idle_connections"]; ok {
-		n, err := v.(json.Number).Int64()
-		if err != nil {
-			log.Printf("[WARN] Non-number %s returned from Vault server: %s", v, err)
-		} else {
-			result["max_idle_connections"] = n
+	for _, k := range []string{"max_open_connections", "max_idle_connections"} {
+		if v, ok := data[k]; ok {
+			n, err := parseutil.ParseInt(v)
+			if err != nil {
+				log.Printf("[WARN] Non-number %s returned from Vault server: %s", v, err)
+			} else {
+				result[k] = n
+			}
 		}
 	}
+	// Vault returns max_connection_lifetime as it was written, either as a
+	// duration string or as a number of seconds.
 	if v, ok := data["max_connection_lifetime"]; ok {
-		n, err := time.ParseDuration(v.(string))
+		n, err := parseutil.ParseDurationSecond(v)
 		if err != nil {
-			log.Printf("[WARN] Non-number %s returned from Vault server: %s", v, err)
+			log.Printf("[WARN] Non-duration %s returned from Vault server: %s", v, err)
 		} else {
-			result["max_connection_lifetime"] = n.Seconds()
+			result["max_connection_lifetime"] = int64(n.Seconds())
 		}
 	}
 	if _, ok := d.GetOk(prefix + "username_template"); ok {
@@ -946,6 +985,35 @@ func getMySQLConnectionDetailsFromResponse(d *schema.ResourceData, prefix string
 	return result
 }
 
+func getPostgresConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) map[string]interface{} {
+	result := getConnectionDetailsFromResponseWithDisableEscaping(d, prefix, resp)
+	if result == nil {
+		return nil
+	}
+
+	details, ok := resp.Data["connection_details"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for _, k := range []string{"tls_ca", "tls_certificate"} {
+		if v, ok := details[k].(string); ok {
+			result[k] = v
+		} else if v, ok := d.GetOk(prefix + k); ok {
+			if s, ok := v.(string); ok {
+				result[k] = s
+			}
+		}
+	}
+	// the private key is never returned by Vault, keep the one we have in state/config.
+	if v, ok := d.GetOk(prefix + "private_key"); ok {
+		if s, ok := v.(string); ok {
+			result["private_key"] = s
+		}
+	}
+
+	return result
+}
+
 func getElasticsearchConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) map[string]interface{} {
 	details := resp.Data["connection_details"]
 	data, ok := details.(map[string]interface{})
@@ -1178,6 +1246,16 @@ func setMySQLDatabaseConnectionData(d *schema.ResourceData, prefix string, data
 	}
 }
 
+func setPostgresDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	setDatabaseConnectionDataWithDisableEscaping(d, prefix, data)
+	// the TLS fields are only sent when configured, since they are not supported by all Vault versions.
+	for _, k := range []string{"tls_ca", "tls_certificate", "private_key"} {
+		if v, ok := d.GetOk(prefix + k); ok {
+			data[k] = v.(string)
+		}
+	}
+}
+
 func setElasticsearchDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
 	if v, ok := d.GetOk(prefix + "url"); ok {
 		data["url"] = v.(string)
@@ -1187,9 +1265,7 @@ func setElasticsearchDatabaseConnectionData(d *schema.ResourceData, prefix strin
 		data["username"] = v.(string)
 	}
 
-	if v, ok := d.GetOk(prefix + "password"); ok {
-		data["password"] = v.(string)
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 
 	if v, ok := d.GetOk(prefix + "ca_cert"); ok {
 		data["ca_cert"] = v.(string)
@@ -1231,9 +1307,7 @@ func setCouchbaseDatabaseConnectionData(d *schema.ResourceData, prefix string, d
 	if v, ok := d.GetOk(prefix + "username"); ok {
 		data["username"] = v.(string)
 	}
-	if v, ok := d.GetOk(prefix + "password"); ok {
-		data["password"] = v.(string)
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 	if v, ok := d.GetOkExists(prefix + "tls"); ok {
 		data["tls"] = v.(bool)
 	}
@@ -1247,7 +1321,7 @@ func setCouchbaseDatabaseConnectionData(d *schema.ResourceData, prefix string, d
 		data["bucket_name"] = v.(string)
 	}
 	if v, ok := d.GetOkExists(prefix + "username_template"); ok {
-		data["username_template"] = v.(int)
+		data["username_template"] = v.(string)
 	}
 }
 
@@ -1261,9 +1335,7 @@ func setInfluxDBDatabaseConnectionData(d *schema.ResourceData, prefix string, da
 	if v, ok := d.GetOk(prefix + "username"); ok {
 		data["username"] = v.(string)
 	}
-	if v, ok := d.GetOk(prefix + "password"); ok {
-		data["password"] = v.(string)
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 	if v, ok := d.GetOkExists(prefix + "tls"); ok {
 		data["tls"] = v.(bool)
 	}
@@ -1280,7 +1352,7 @@ func setInfluxDBDatabaseConnectionData(d *schema.ResourceData, prefix string, da
 		data["connect_timeout"] = v.(int)
 	}
 	if v, ok := d.GetOkExists(prefix + "username_template"); ok {
-		data["username_template"] = v.(int)
+		data["username_template"] = v.(string)
 	}
 }
 
@@ -1289,7 +1361,15 @@ func setDatabaseConnectionDataWithUserPass(d *schema.ResourceData, prefix string
 	if v, ok := d.GetOk(prefix + "username"); ok {
 		data["username"] = v.(string)
 	}
-	if v, ok := d.GetOk(prefix + "password"); ok {
+	setDatabaseConnectionPassword(d, prefix, data)
+}
+
+// setDatabaseConnectionPassword only sends the password on create or when it
+// changes. Vault does not return the password, and sending the configured one
+// after the root credentials have been rotated would break the connection.
+func setDatabaseConnectionPassword(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	k := prefix + "password"
+	if v, ok := d.GetOk(k); ok && (d.IsNewResource() || d.HasChange(k)) {
 		data["password"] = v.(string)
 	}
 }
@@ -1473,6 +1553,11 @@ func databaseSecretBackendConnectionRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	// force is provider only, ensure it is set on import.
+	if err := d.Set("force", d.Get("force")); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1541,15 +1626,15 @@ func getDBConnectionConfig(d *schema.ResourceData, engine *dbEngine, idx int,
 	case dbEngineMySQL:
 		result = getMySQLConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineMySQLRDS:
-		result = getConnectionDetailsFromResponseWithUserPass(d, prefix, resp)
+		result = getMySQLConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineMySQLAurora:
-		result = getConnectionDetailsFromResponseWithUserPass(d, prefix, resp)
+		result = getMySQLConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineMySQLLegacy:
-		result = getConnectionDetailsFromResponseWithUserPass(d, prefix, resp)
+		result = getMySQLConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineOracle:
 		result = getConnectionDetailsFromResponseWithUserPass(d, prefix, resp)
 	case dbEnginePostgres:
-		result = getConnectionDetailsFromResponseWithDisableEscaping(d, prefix, resp)
+		result = getPostgresConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineElasticSearch:
 		result = getElasticsearchConnectionDetailsFromResponse(d, prefix, resp)
 	case dbEngineSnowflake:
@@ -1618,6 +1703,9 @@ func getConnectionDetailsCassandra(d *schema.ResourceData, prefix string, resp *
 			}
 			result["connect_timeout"] = timeout
 		}
+		if v, ok := data["username_template"]; ok {
+			result["username_template"] = v.(string)
+		}
 		return result, nil
 	}
 	return nil, nil
@@ -1630,7 +1718,7 @@ func getConnectionDetailsMongoDBAtlas(d *schema.ResourceData, prefix string, res
 	}
 	if details, ok := resp.Data["connection_details"]; ok {
 		if data, ok := details.(map[string]interface{}); ok {
-			for _, k := range []string{"public_key", "project_id"} {
+			for _, k := range []string{"public_key", "project_id", "username_template"} {
 				result[k] = data[k]
 			}
 		}
@@ -1647,6 +1735,33 @@ func databaseSecretBackendConnectionDelete(d *schema.ResourceData, meta interfac
 
 	path := d.Id()
 
+	if !d.Get("force").(bool) {
+		backend, err := databaseSecretBackendConnectionBackendFromPath(path)
+		if err != nil {
+			return fmt.Errorf("invalid path %q for database connection: %s", path, err)
+		}
+
+		name, err := databaseSecretBackendConnectionNameFromPath(path)
+		if err != nil {
+			return fmt.Errorf("invalid path %q for database connection: %s", path, err)
+		}
+
+		roles, err := databaseSecretBackendConnectionRoles(client, backend, name)
+		if err != nil {
+			if util.ErrorContainsHTTPCode(err, http.StatusForbidden) {
+				return fmt.Errorf("%s, checking for roles that reference database connection config %q "+
+					"requires the list and read capabilities on %s/roles and %s/static-roles, "+
+					"grant them or set force to true to skip the check", err, path, backend, backend)
+			}
+			return err
+		}
+
+		if len(roles) > 0 {
+			return fmt.Errorf("database connection config %q is still referenced by roles %s, "+
+				"remove them first or set force to true", path, strings.Join(roles, ", "))
+		}
+	}
+
 	log.Printf("[DEBUG] Removing database connection config %q", path)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
@@ -1657,6 +1772,37 @@ func databaseSecretBackendConnectionDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// databaseSecretBackendConnectionRoles returns the paths of all roles and static roles
+// on the backend that reference the named connection.
+func databaseSecretBackendConnectionRoles(client *api.Client, backend, name string) ([]string, error) {
+	var roles []string
+	for _, kind := range []string{"roles", "static-roles"} {
+		listPath := strings.Trim(backend, "/") + "/" + kind
+		log.Printf("[DEBUG] Listing database %s %q", kind, listPath)
+		resp, err := client.Logical().List(listPath)
+		if err != nil {
+			return nil, fmt.Errorf("error listing database %s %q: %s", kind, listPath, err)
+		}
+		if resp == nil {
+			continue
+		}
+
+		keys, _ := resp.Data["keys"].([]interface{})
+		for _, k := range keys {
+			rolePath := listPath + "/" + k.(string)
+			role, err := client.Logical().Read(rolePath)
+			if err != nil {
+				return nil, fmt.Errorf("error reading database role %q: %s", rolePath, err)
+			}
+			if role != nil && role.Data["db_name"] == name {
+				roles = append(roles, rolePath)
+			}
+		}
+	}
+
+	return roles, nil
+}
+
 func databaseSecretBackendConnectionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
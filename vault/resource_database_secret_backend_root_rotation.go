@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func databaseSecretBackendRootRotationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: databaseSecretBackendRootRotationCreate,
+		Read:   databaseSecretBackendRootRotationRead,
+		Delete: databaseSecretBackendRootRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Database Secret Backend the connection belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the database connection whose root credentials should be rotated.",
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Description: "Arbitrary map of values that, when changed, will rotate " +
+					"the root credentials again.",
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func databaseSecretBackendRootRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := databaseSecretBackendRootRotationPath(backend, name)
+
+	log.Printf("[DEBUG] Rotating root credentials for database connection %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{}); err != nil {
+		return fmt.Errorf("error rotating root credentials for database connection %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rotated root credentials for database connection %q", path)
+
+	d.SetId(path)
+
+	return databaseSecretBackendRootRotationRead(d, meta)
+}
+
+func databaseSecretBackendRootRotationRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := databaseSecretBackendConnectionPath(d.Get("backend").(string), d.Get("name").(string))
+
+	log.Printf("[DEBUG] Reading database connection config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading database connection config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read database connection config %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Database connection config %q not found, removing root rotation from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+// databaseSecretBackendRootRotationDelete only removes the resource from the
+// state, there is no way to restore the previous root credentials.
+func databaseSecretBackendRootRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func databaseSecretBackendRootRotationPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/rotate-root/" + strings.Trim(name, "/")
+}
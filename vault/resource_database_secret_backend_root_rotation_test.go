@@ -0,0 +1,135 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDatabaseSecretBackendRootRotation_mysql(t *testing.T) {
+	MaybeSkipDBTests(t, dbEngineMySQL)
+
+	values := testutil.SkipTestEnvUnset(t,
+		"MYSQL_CONNECTION_URL",
+		"MYSQL_CONNECTION_USERNAME",
+		"MYSQL_CONNECTION_PASSWORD")
+
+	connURL, username, password := values[0], values[1], values[2]
+
+	testConnURL := os.Getenv("MYSQL_TEMPLATED_URL")
+	if testConnURL == "" {
+		testConnURL = connURL
+	}
+
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("db")
+	resourceName := "vault_database_secret_backend_root_rotation.test"
+
+	// use a dedicated root user, since its password will no longer be known after rotation.
+	rootUsername := acctest.RandomWithPrefix("username")
+	rootPassword := acctest.RandomWithPrefix("password")
+	db := newMySQLConnection(t, connURL, username, password)
+	createMySQSUser(t, db, rootUsername, rootPassword)
+	t.Cleanup(func() {
+		deleteMySQLUser(t, db, rootUsername)
+	})
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendRootRotationConfig(name, backend, testConnURL, rootUsername, rootPassword, `["dev", "prod"]`, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/rotate-root/"+name),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					testAccDatabaseSecretBackendRootRotationCheckRotated(t, connURL, rootUsername, rootPassword),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendRootRotationConfig(name, backend, testConnURL, rootUsername, rootPassword, `["dev", "prod"]`, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2"),
+				),
+			},
+			{
+				// an unrelated update must not send the original root password,
+				// otherwise the connection is verified against stale credentials.
+				Config: testAccDatabaseSecretBackendRootRotationConfig(name, backend, testConnURL, rootUsername, rootPassword, `["dev"]`, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_connection.test", "allowed_roles.#", "1"),
+					testAccDatabaseSecretBackendRootRotationCheckReset(backend, name),
+				),
+			},
+		},
+	})
+}
+
+// testAccDatabaseSecretBackendRootRotationCheckRotated ensures the original
+// root credentials no longer work after rotation.
+func testAccDatabaseSecretBackendRootRotationCheckRotated(t *testing.T, connURL, username, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db := newMySQLConnection(t, connURL, username, password)
+		defer db.Close()
+
+		if err := db.Ping(); err == nil {
+			return fmt.Errorf("expected the original root credentials for %q to be rotated", username)
+		}
+
+		return nil
+	}
+}
+
+// testAccDatabaseSecretBackendRootRotationCheckReset ensures Vault can still
+// connect to the database with the stored, rotated root credentials.
+func testAccDatabaseSecretBackendRootRotationCheckReset(backend, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+		path := backend + "/reset/" + name
+		if _, err := client.Logical().Write(path, nil); err != nil {
+			return fmt.Errorf("error resetting the database connection %q: %s", path, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccDatabaseSecretBackendRootRotationConfig(name, path, connURL, username, password, allowedRoles, trigger string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.db.path
+  name          = "%s"
+  allowed_roles = %s
+
+  mysql {
+    connection_url = "%s"
+    username       = "%s"
+    password       = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_root_rotation" "test" {
+  backend = vault_mount.db.path
+  name    = vault_database_secret_backend_connection.test.name
+
+  triggers = {
+    rotation = "%s"
+  }
+}
+`, path, name, allowedRoles, connURL, username, password, trigger)
+}
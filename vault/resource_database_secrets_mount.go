@@ -223,6 +223,7 @@ func databaseSecretsMountCreateOrUpdate(d *schema.ResourceData, meta interface{}
 		if err := createMount(d, client, root, consts.MountTypeDatabase); err != nil {
 			return err
 		}
+		d.SetId(root)
 	} else {
 		if err := mountUpdate(d, meta); err != nil {
 			return err
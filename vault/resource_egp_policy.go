@@ -2,6 +2,8 @@ package vault
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
 var egpPolicyAttributes = []string{"enforcement_level", "paths", "policy"}
@@ -13,7 +15,7 @@ func egpPolicyResource() *schema.Resource {
 		Delete: egpPolicyDelete,
 		Read:   egpPolicyRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: provider.ImportStatePassthroughNamespace,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -2,12 +2,14 @@ package vault
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
@@ -41,6 +43,64 @@ func TestAccEndpointGoverningPolicy(t *testing.T) {
 	})
 }
 
+func TestAccEndpointGoverningPolicy_namespace(t *testing.T) {
+	ns := acctest.RandomWithPrefix("ns")
+	policyName := acctest.RandomWithPrefix("test-policy")
+	resourceName := "vault_egp_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccEndpointGoverningPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointGoverningPolicy_namespace(ns, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "namespace", ns),
+					resource.TestCheckResourceAttr(resourceName, "name", policyName),
+					resource.TestCheckResourceAttr(resourceName, "paths.0", "test/*"),
+				),
+			},
+			{
+				PreConfig: func() {
+					t.Setenv(consts.EnvVarVaultNamespaceImport, ns)
+				},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// needed for the import step above
+				Config: testAccEndpointGoverningPolicy_namespace(ns, policyName),
+				PreConfig: func() {
+					os.Unsetenv(consts.EnvVarVaultNamespaceImport)
+				},
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccEndpointGoverningPolicy_namespace(ns, policyName string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = "%s"
+}
+
+resource "vault_egp_policy" "test" {
+  namespace         = vault_namespace.test.path
+  name              = "%s"
+  paths             = ["test/*"]
+  enforcement_level = "soft-mandatory"
+  policy            = <<EOT
+main = rule {
+  2+2 > 3
+}
+EOT
+}
+`, ns, policyName)
+}
+
 func testAccEndpointGoverningPolicyCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_egp_policy" {
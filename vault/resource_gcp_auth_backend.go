@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -74,6 +75,50 @@ func gcpAuthBackendResource() *schema.Resource {
 				Optional:    true,
 				Description: "Specifies if the auth method is local only",
 			},
+			"iam_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Defines what alias needs to be used during login and reflects the same in the entity alias for the iam auth type. One of role_id or unique_id.",
+				ValidateFunc: validation.StringInSlice([]string{"role_id", "unique_id"}, false),
+			},
+			"gce_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Defines what alias needs to be used during login and reflects the same in the entity alias for the gce auth type. One of role_id or instance_id.",
+				ValidateFunc: validation.StringInSlice([]string{"role_id", "instance_id"}, false),
+			},
+			"custom_endpoint": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Specifies overrides to service endpoints used when making API requests to GCP.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Replaces the service endpoint used in API requests to https://www.googleapis.com.",
+						},
+						"iam": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Replaces the service endpoint used in API requests to https://iam.googleapis.com.",
+						},
+						"crm": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Replaces the service endpoint used in API requests to https://cloudresourcemanager.googleapis.com.",
+						},
+						"compute": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Replaces the service endpoint used in API requests to https://compute.googleapis.com.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -109,6 +154,38 @@ func NormalizeCredentials(configI interface{}) string {
 	return string(ret)
 }
 
+var gcpAuthCustomEndpointFields = []string{
+	"api",
+	"iam",
+	"crm",
+	"compute",
+}
+
+// flattenGCPAuthCustomEndpoint returns the custom_endpoint block from Vault's
+// response, or nil if no endpoint overrides are configured.
+func flattenGCPAuthCustomEndpoint(v interface{}) []map[string]interface{} {
+	endpoints, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var found bool
+	result := map[string]interface{}{}
+	for _, k := range gcpAuthCustomEndpointFields {
+		endpoint, _ := endpoints[k].(string)
+		if endpoint != "" {
+			found = true
+		}
+		result[k] = endpoint
+	}
+
+	if !found {
+		return nil
+	}
+
+	return []map[string]interface{}{result}
+}
+
 func gcpAuthBackendConfigPath(path string) string {
 	return "auth/" + strings.Trim(path, "/") + "/config"
 }
@@ -153,6 +230,20 @@ func gcpAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["credentials"] = v.(string)
 	}
 
+	for _, k := range []string{"iam_alias", "gce_alias"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+
+	if d.HasChange("custom_endpoint") {
+		endpoints := map[string]interface{}{}
+		for _, k := range gcpAuthCustomEndpointFields {
+			endpoints[k] = d.Get("custom_endpoint.0." + k).(string)
+		}
+		data["custom_endpoint"] = endpoints
+	}
+
 	log.Printf("[DEBUG] Writing gcp config %q", path)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -191,6 +282,8 @@ func gcpAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 		"project_id",
 		"client_email",
 		"local",
+		"iam_alias",
+		"gce_alias",
 	}
 
 	for _, param := range params {
@@ -199,6 +292,10 @@ func gcpAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := d.Set("custom_endpoint", flattenGCPAuthCustomEndpoint(resp.Data["custom_endpoint"])); err != nil {
+		return err
+	}
+
 	// set the auth backend's path
 	if err := d.Set("path", d.Id()); err != nil {
 		return err
@@ -278,9 +278,7 @@ func gcpAuthResourceRead(_ context.Context, d *schema.ResourceData, meta interfa
 		d.Set("type", v)
 	}
 
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
-
-	return diags
+	return nil
 }
 
 func gcpAuthResourceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
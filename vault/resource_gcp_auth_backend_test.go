@@ -42,6 +42,38 @@ func TestGCPAuthBackend_basic(t *testing.T) {
 	})
 }
 
+func TestGCPAuthBackend_customEndpoint(t *testing.T) {
+	path := resource.PrefixedUniqueId("gcp-endpoint-")
+	resourceName := "vault_gcp_auth_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testGCPAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPAuthBackendConfig_customEndpoint(path, gcpJSONCredentials),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "iam_alias", "unique_id"),
+					resource.TestCheckResourceAttr(resourceName, "gce_alias", "instance_id"),
+					resource.TestCheckResourceAttr(resourceName, "custom_endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_endpoint.0.api", "www.googleapis.com"),
+					resource.TestCheckResourceAttr(resourceName, "custom_endpoint.0.iam", "iam.googleapis.com"),
+					resource.TestCheckResourceAttr(resourceName, "custom_endpoint.0.crm", "cloudresourcemanager.googleapis.com"),
+					resource.TestCheckResourceAttr(resourceName, "custom_endpoint.0.compute", "compute.googleapis.com"),
+				),
+			},
+			{
+				Config: testGCPAuthBackendConfig_basic(path, gcpJSONCredentials),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "iam_alias", "unique_id"),
+					resource.TestCheckResourceAttr(resourceName, "custom_endpoint.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestGCPAuthBackend_import(t *testing.T) {
 	path := resource.PrefixedUniqueId("gcp-import-")
 
@@ -117,3 +149,26 @@ resource "vault_gcp_auth_backend" "test" {
 }
 `, credentials, path)
 }
+
+func testGCPAuthBackendConfig_customEndpoint(path, credentials string) string {
+	return fmt.Sprintf(`
+variable "json_credentials" {
+  type = string
+  default = %q
+}
+
+resource "vault_gcp_auth_backend" "test" {
+  path        = %q
+  credentials = var.json_credentials
+  iam_alias   = "unique_id"
+  gce_alias   = "instance_id"
+
+  custom_endpoint {
+    api     = "www.googleapis.com"
+    iam     = "iam.googleapis.com"
+    crm     = "cloudresourcemanager.googleapis.com"
+    compute = "compute.googleapis.com"
+  }
+}
+`, credentials, path)
+}
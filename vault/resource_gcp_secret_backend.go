@@ -6,11 +6,21 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
+// gcpSecretBackendWIFFields are the config fields used to configure plugin
+// workload identity federation.
+var gcpSecretBackendWIFFields = []string{
+	consts.FieldIdentityTokenAudience,
+	consts.FieldIdentityTokenTTL,
+	"service_account_email",
+}
+
 func gcpSecretBackendResource(name string) *schema.Resource {
 	return &schema.Resource{
 		Create: gcpSecretBackendCreate,
@@ -49,8 +59,31 @@ func gcpSecretBackendResource(name string) *schema.Resource {
 				// string. This makes terraform not want to change when an extra
 				// space is included in the JSON string. It is also necesarry
 				// when disable_read is false for comparing values.
-				StateFunc:    NormalizeDataJSONFunc(name),
-				ValidateFunc: ValidateDataJSONFunc(name),
+				StateFunc:     NormalizeDataJSONFunc(name),
+				ValidateFunc:  ValidateDataJSONFunc(name),
+				ConflictsWith: []string{consts.FieldIdentityTokenAudience},
+			},
+			consts.FieldIdentityTokenAudience: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The audience claim value of the plugin identity token.",
+				RequiredWith:  []string{"service_account_email"},
+				ConflictsWith: []string{"credentials"},
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+			},
+			consts.FieldIdentityTokenTTL: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The TTL of the generated plugin identity token in seconds.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"service_account_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Service Account to impersonate with the plugin identity token.",
+				RequiredWith: []string{consts.FieldIdentityTokenAudience},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -115,10 +148,16 @@ func gcpSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(path)
 
 	log.Printf("[DEBUG] Writing GCP configuration to %q", configPath)
+	data := map[string]interface{}{}
 	if credentials != "" {
-		data := map[string]interface{}{
-			"credentials": credentials,
+		data["credentials"] = credentials
+	}
+	for _, k := range gcpSecretBackendWIFFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
 		}
+	}
+	if len(data) > 0 {
 		if _, err := client.Logical().Write(configPath, data); err != nil {
 			return fmt.Errorf("error writing GCP configuration for %q: %s", path, err)
 		}
@@ -161,6 +200,23 @@ func gcpSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
 	d.Set("local", mount.Local)
 
+	configPath := gcpSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading GCP configuration from %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading GCP configuration for %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read GCP configuration from %q", configPath)
+	if resp != nil {
+		for _, k := range gcpSecretBackendWIFFields {
+			if v, ok := resp.Data[k]; ok {
+				if err := d.Set(k, v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -196,6 +252,20 @@ func gcpSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Updated credentials for %q", path)
 	}
 
+	if d.HasChanges(gcpSecretBackendWIFFields...) {
+		data := map[string]interface{}{}
+		for _, k := range gcpSecretBackendWIFFields {
+			if d.HasChange(k) {
+				data[k] = d.Get(k)
+			}
+		}
+		configPath := gcpSecretBackendConfigPath(path)
+		if _, err := client.Logical().Write(configPath, data); err != nil {
+			return fmt.Errorf("error writing GCP identity token configuration for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated identity token configuration for %q", path)
+	}
+
 	d.Partial(false)
 	return gcpSecretBackendRead(d, meta)
 }
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -46,6 +47,38 @@ func TestGCPSecretBackend(t *testing.T) {
 	})
 }
 
+func TestGCPSecretBackend_identityToken(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-gcp")
+	resourceName := "vault_gcp_secret_backend.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testAccGCPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testGCPSecretBackend_identityTokenWithCredentials(path),
+				ExpectError: regexp.MustCompile(`"identity_token_audience": conflicts with credentials`),
+			},
+			{
+				Config: testGCPSecretBackend_identityToken(path, "vault.example.com", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identity_token_audience", "vault.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_email", "vault@example.iam.gserviceaccount.com"),
+				),
+			},
+			{
+				Config: testGCPSecretBackend_identityToken(path, "vault-updated.example.com", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identity_token_audience", "vault-updated.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "identity_token_ttl", "1800"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_email", "vault@example.iam.gserviceaccount.com"),
+				),
+			},
+		},
+	})
+}
+
 func testAccGCPSecretBackendCheckDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_gcp_secret_backend" {
@@ -102,3 +135,23 @@ EOF
   local = true
 }`, path)
 }
+
+func testGCPSecretBackend_identityToken(path, audience string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path                    = "%s"
+  identity_token_audience = "%s"
+  identity_token_ttl      = %d
+  service_account_email   = "vault@example.iam.gserviceaccount.com"
+}`, path, audience, ttl)
+}
+
+func testGCPSecretBackend_identityTokenWithCredentials(path string) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path                    = "%s"
+  credentials             = "{\"hello\": \"world\"}"
+  identity_token_audience = "vault.example.com"
+  service_account_email   = "vault@example.iam.gserviceaccount.com"
+}`, path)
+}
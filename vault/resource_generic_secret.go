@@ -63,6 +63,16 @@ func genericSecretResource(name string) *schema.Resource {
 				Sensitive:   true,
 			},
 
+			"ignore_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Description: "Top-level keys of the secret that are excluded when comparing the secret " +
+					"read from Vault with data_json, e.g. fields that are managed outside of Terraform.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"delete_all_versions": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -209,7 +219,7 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		data = secret.Data
-		jsonData, err := json.Marshal(secret.Data)
+		jsonData, err := json.Marshal(genericSecretIgnoreFields(d, secret.Data))
 		if err != nil {
 			return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
 		}
@@ -245,6 +255,38 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// genericSecretIgnoreFields returns a copy of the data read from Vault, where the
+// ignore_fields are excluded, unless they are part of the configured data_json,
+// in which case the configured value is retained, so that they never result in a diff.
+func genericSecretIgnoreFields(d *schema.ResourceData, data map[string]interface{}) map[string]interface{} {
+	v, ok := d.GetOk("ignore_fields")
+	if !ok {
+		return data
+	}
+
+	current := map[string]interface{}{}
+	if s := d.Get(consts.FieldDataJSON).(string); s != "" {
+		if err := json.Unmarshal([]byte(s), &current); err != nil {
+			log.Printf("[WARN] Failed to decode data_json for %q, err=%s", d.Id(), err)
+		}
+	}
+
+	result := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		result[k] = v
+	}
+	for _, i := range v.(*schema.Set).List() {
+		k := i.(string)
+		if v, ok := current[k]; ok {
+			result[k] = v
+		} else {
+			delete(result, k)
+		}
+	}
+
+	return result
+}
+
 func serializeDataMapToString(data map[string]interface{}) map[string]string {
 	// Since our "data" map can only contain string values, we
 	// will take strings from Data and write them in as-is,
@@ -156,6 +156,74 @@ func TestResourceGenericSecret_deleteAllVersions(t *testing.T) {
 	})
 }
 
+func TestResourceGenericSecret_ignoreFields(t *testing.T) {
+	resourceName := "vault_generic_secret.test"
+
+	mount := acctest.RandomWithPrefix("secretsv1")
+	name := acctest.RandomWithPrefix("test")
+	path := fmt.Sprintf("%s/%s", mount, name)
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_ignoreFieldsConfig(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data_json", `{"ttl":"1h","zip":"zap"}`),
+					resource.TestCheckResourceAttr(resourceName, "ignore_fields.#", "2"),
+				),
+			},
+			{
+				// fields added or updated outside of Terraform should not result in a diff.
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+					_, err := client.Logical().Write(path, map[string]interface{}{
+						"zip":     "zap",
+						"ttl":     "2h",
+						"managed": "elsewhere",
+					})
+					if err != nil {
+						t.Fatalf("unable to manually update the secret via the SDK: %s", err)
+					}
+				},
+				Config:   testResourceGenericSecret_ignoreFieldsConfig(mount, name),
+				PlanOnly: true,
+			},
+			{
+				Config: testResourceGenericSecret_ignoreFieldsConfig(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data_json", `{"ttl":"1h","zip":"zap"}`),
+					resource.TestCheckResourceAttr(resourceName, "data.ttl", "2h"),
+					resource.TestCheckResourceAttr(resourceName, "data.managed", "elsewhere"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceGenericSecret_ignoreFieldsConfig(mount, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "v1" {
+	path = "%s"
+	type = "kv"
+	options = {
+		version = "1"
+	}
+}
+
+resource "vault_generic_secret" "test" {
+    path          = "${vault_mount.v1.path}/%s"
+    ignore_fields = ["ttl", "managed"]
+    data_json = <<EOT
+{
+    "zip": "zap",
+    "ttl": "1h"
+}
+EOT
+}`, mount, name)
+}
+
 func testResourceGenericSecret_initialConfig(mount, name string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "v1" {
@@ -212,5 +212,10 @@ func githubAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func githubAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
-	return authMountDisable(meta.(*provider.ProviderMeta).GetClient(), d.Id())
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	return authMountDisable(client, d.Id())
 }
@@ -24,7 +24,7 @@ func githubTeamResource() *schema.Resource {
 			"backend": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Auth backend to which team mapping will be congigured.",
+				Description: "Auth backend to which team mapping will be configured.",
 				ForceNew:    true,
 				Default:     "github",
 				// standardise on no beginning or trailing slashes
@@ -97,6 +97,11 @@ func githubTeamRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[ERROR] error when reading github team mapping from '%s'", path)
 		return err
 	}
+	if dt == nil {
+		log.Printf("[WARN] github team mapping %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
 
 	if v, ok := dt.Data["key"]; ok {
 		d.Set("team", v.(string))
@@ -122,9 +127,12 @@ func githubTeamDelete(d *schema.ResourceData, meta interface{}) error {
 		return e
 	}
 
+	log.Printf("[DEBUG] Deleting github team map at '%v'", d.Id())
 	_, err := client.Logical().Delete(d.Id())
 	if err != nil {
 		return err
 	}
+	log.Printf("[DEBUG] Deleted github team map at '%v'", d.Id())
+
 	return nil
 }
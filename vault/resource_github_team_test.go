@@ -44,6 +44,21 @@ func TestAccGithubTeam_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "policies.#", "0"),
 				),
 			},
+			{
+				// the mapping is recreated when removed outside of Terraform.
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+					if _, err := client.Logical().Delete("auth/" + backend + "/map/teams/" + team); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccGithubTeamConfig_basic(backend, team, []string{"admin"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "auth/"+backend+"/map/teams/"+team),
+					resource.TestCheckResourceAttr(resName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(resName, "policies.0", "admin"),
+				),
+			},
 		},
 	})
 }
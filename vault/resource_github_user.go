@@ -24,7 +24,7 @@ func githubUserResource() *schema.Resource {
 			"backend": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Auth backend to which user mapping will be congigured.",
+				Description: "Auth backend to which user mapping will be configured.",
 				ForceNew:    true,
 				Default:     "github",
 				// standardise on no beginning or trailing slashes
@@ -96,6 +96,11 @@ func githubUserRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[ERROR] error when reading github user mapping from '%s'", path)
 		return err
 	}
+	if dt == nil {
+		log.Printf("[WARN] github user mapping %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
 
 	if v, ok := dt.Data["key"]; ok {
 		d.Set("user", v.(string))
@@ -121,9 +126,12 @@ func githubUserDelete(d *schema.ResourceData, meta interface{}) error {
 		return e
 	}
 
+	log.Printf("[DEBUG] Deleting github user map at '%v'", d.Id())
 	_, err := client.Logical().Delete(d.Id())
 	if err != nil {
 		return err
 	}
+	log.Printf("[DEBUG] Deleted github user map at '%v'", d.Id())
+
 	return nil
 }
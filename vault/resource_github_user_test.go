@@ -43,6 +43,21 @@ func TestAccGithubUser_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "policies.#", "0"),
 				),
 			},
+			{
+				// the mapping is recreated when removed outside of Terraform.
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+					if _, err := client.Logical().Delete("auth/" + backend + "/map/users/" + user); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccGithubUserConfig_basic(backend, user, []string{"admin"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "auth/"+backend+"/map/users/"+user),
+					resource.TestCheckResourceAttr(resName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(resName, "policies.0", "admin"),
+				),
+			},
 		},
 	})
 }
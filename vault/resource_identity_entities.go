@@ -0,0 +1,267 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/helper"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const fieldEntityIDs = "entity_ids"
+
+func identityEntitiesResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        identityEntitiesCreate,
+		Update:        identityEntitiesUpdate,
+		Read:          identityEntitiesRead,
+		Delete:        identityEntitiesDelete,
+		CustomizeDiff: identityEntitiesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"entity": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The set of entities to manage, keyed by their name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the entity.",
+						},
+						consts.FieldMetadata: {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Metadata to be associated with the entity.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"policies": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Policies to be tied to the entity.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the entity is disabled.",
+						},
+					},
+				},
+			},
+			fieldEntityIDs: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Mapping of entity name to entity ID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func identityEntitiesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	names := map[string]bool{}
+	for _, name := range identityEntitiesNames(d.Get("entity").([]interface{})) {
+		if names[name] {
+			return fmt.Errorf("duplicate entity name %q", name)
+		}
+		names[name] = true
+	}
+
+	if d.HasChange("entity") {
+		o, n := d.GetChange("entity")
+		oldNames := identityEntitiesNames(o.([]interface{}))
+		newNames := identityEntitiesNames(n.([]interface{}))
+		sort.Strings(oldNames)
+		sort.Strings(newNames)
+		if strings.Join(oldNames, ",") != strings.Join(newNames, ",") {
+			return d.SetNewComputed(fieldEntityIDs)
+		}
+	}
+
+	return nil
+}
+
+func identityEntitiesNames(entities []interface{}) []string {
+	var names []string
+	for _, e := range entities {
+		if e == nil {
+			continue
+		}
+		names = append(names, e.(map[string]interface{})[consts.FieldName].(string))
+	}
+
+	return names
+}
+
+func identityEntitiesRequestData(e map[string]interface{}) map[string]interface{} {
+	data := map[string]interface{}{
+		"metadata": e[consts.FieldMetadata],
+		"disabled": e["disabled"],
+		"policies": []interface{}{},
+	}
+
+	if v, ok := e["policies"]; ok && v != nil {
+		data["policies"] = v.(*schema.Set).List()
+	}
+
+	return data
+}
+
+func identityEntitiesWrite(client *api.Client, entities []interface{}) error {
+	for _, e := range entities {
+		entity := e.(map[string]interface{})
+		name := entity[consts.FieldName].(string)
+		path := identityEntityNamePath(name)
+
+		log.Printf("[DEBUG] Writing IdentityEntity %q", name)
+		vaultMutexKV.Lock(path)
+		_, err := client.Logical().Write(path, identityEntitiesRequestData(entity))
+		vaultMutexKV.Unlock(path)
+		if err != nil {
+			return fmt.Errorf("error writing IdentityEntity %q: %w", name, err)
+		}
+		log.Printf("[DEBUG] Wrote IdentityEntity %q", name)
+	}
+
+	return nil
+}
+
+func identityEntitiesDeleteNames(client *api.Client, names []string) error {
+	for _, name := range names {
+		path := identityEntityNamePath(name)
+
+		log.Printf("[DEBUG] Deleting IdentityEntity %q", name)
+		vaultMutexKV.Lock(path)
+		_, err := client.Logical().Delete(path)
+		vaultMutexKV.Unlock(path)
+		if err != nil {
+			return fmt.Errorf("error deleting IdentityEntity %q: %w", name, err)
+		}
+		log.Printf("[DEBUG] Deleted IdentityEntity %q", name)
+	}
+
+	return nil
+}
+
+func identityEntitiesCreate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	entities := d.Get("entity").([]interface{})
+	if err := identityEntitiesWrite(client, entities); err != nil {
+		return err
+	}
+
+	names := identityEntitiesNames(entities)
+	sort.Strings(names)
+	d.SetId(strconv.Itoa(helper.HashCodeString(strings.Join(names, ","))))
+
+	return identityEntitiesRead(d, meta)
+}
+
+func identityEntitiesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	if d.HasChange("entity") {
+		o, n := d.GetChange("entity")
+
+		current := map[string]bool{}
+		for _, name := range identityEntitiesNames(n.([]interface{})) {
+			current[name] = true
+		}
+
+		var removed []string
+		for _, name := range identityEntitiesNames(o.([]interface{})) {
+			if !current[name] {
+				removed = append(removed, name)
+			}
+		}
+
+		if err := identityEntitiesDeleteNames(client, removed); err != nil {
+			return err
+		}
+
+		if err := identityEntitiesWrite(client, n.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return identityEntitiesRead(d, meta)
+}
+
+func identityEntitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	var entities []interface{}
+	ids := map[string]interface{}{}
+	for _, name := range identityEntitiesNames(d.Get("entity").([]interface{})) {
+		resp, err := readEntity(client, identityEntityNamePath(name), d.IsNewResource())
+		if err != nil {
+			if isIdentityNotFoundError(err) {
+				log.Printf("[WARN] IdentityEntity %q not found, removing from state", name)
+				continue
+			}
+			return fmt.Errorf("error reading IdentityEntity %q: %w", name, err)
+		}
+
+		entity := map[string]interface{}{
+			consts.FieldName: name,
+		}
+		for _, k := range []string{consts.FieldMetadata, "policies", "disabled"} {
+			entity[k] = resp.Data[k]
+		}
+
+		entities = append(entities, entity)
+		ids[name] = resp.Data["id"]
+	}
+
+	if len(entities) == 0 {
+		log.Printf("[WARN] No IdentityEntities found for %q, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("entity", entities); err != nil {
+		return fmt.Errorf("error setting state key \"entity\" on IdentityEntities %q: %w", d.Id(), err)
+	}
+
+	if err := d.Set(fieldEntityIDs, ids); err != nil {
+		return fmt.Errorf("error setting state key %q on IdentityEntities %q: %w", fieldEntityIDs, d.Id(), err)
+	}
+
+	return nil
+}
+
+func identityEntitiesDelete(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	return identityEntitiesDeleteNames(client, identityEntitiesNames(d.Get("entity").([]interface{})))
+}
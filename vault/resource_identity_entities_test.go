@@ -0,0 +1,165 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccIdentityEntities(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-entities")
+
+	resourceName := "vault_identity_entities.test"
+	var firstID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntitiesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntitiesConfig(prefix, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entity.0.name", prefix+"-1"),
+					resource.TestCheckResourceAttr(resourceName, "entity.0.policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entity.0.metadata.team", "a"),
+					resource.TestCheckResourceAttr(resourceName, "entity.1.name", prefix+"-2"),
+					resource.TestCheckResourceAttr(resourceName, "entity.1.disabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "entity_ids.%", "2"),
+					testAccIdentityEntitiesGetID(resourceName, prefix+"-1", &firstID),
+				),
+			},
+			{
+				Config: testAccIdentityEntitiesConfig(prefix, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entity.0.name", prefix+"-1"),
+					resource.TestCheckResourceAttr(resourceName, "entity.0.policies.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entity.1.name", prefix+"-3"),
+					resource.TestCheckResourceAttr(resourceName, "entity_ids.%", "2"),
+					testAccIdentityEntitiesCheckID(resourceName, prefix+"-1", &firstID),
+					testAccIdentityEntitiesCheckDeleted(prefix+"-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityEntitiesGetID(resourceName, name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		*id = rs.Primary.Attributes[fmt.Sprintf("%s.%s", fieldEntityIDs, name)]
+		if *id == "" {
+			return fmt.Errorf("no entity ID found for %q", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccIdentityEntitiesCheckID(resourceName, name string, expected *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var actual string
+		if err := testAccIdentityEntitiesGetID(resourceName, name, &actual)(s); err != nil {
+			return err
+		}
+
+		if actual != *expected {
+			return fmt.Errorf("expected entity ID for %q to be stable, got %q, want %q", name, actual, *expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccIdentityEntitiesCheckDeleted(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+		resp, err := client.Logical().Read(identityEntityNamePath(name))
+		if err != nil {
+			return err
+		}
+
+		if resp != nil {
+			return fmt.Errorf("identity entity %q still exists", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityEntitiesDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_entities" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, fieldEntityIDs+".") || k == fieldEntityIDs+".%" {
+				continue
+			}
+
+			resp, err := readIdentityEntity(client, v, false)
+			if err != nil && !isIdentityNotFoundError(err) {
+				return err
+			}
+			if resp != nil {
+				return fmt.Errorf("identity entity %q still exists", v)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccIdentityEntitiesConfig(prefix string, updated bool) string {
+	if updated {
+		return fmt.Sprintf(`
+resource "vault_identity_entities" "test" {
+  entity {
+    name     = "%[1]s-1"
+    policies = ["dev", "test"]
+    metadata = {
+      team = "a"
+    }
+  }
+
+  entity {
+    name = "%[1]s-3"
+  }
+}
+`, prefix)
+	}
+
+	return fmt.Sprintf(`
+resource "vault_identity_entities" "test" {
+  entity {
+    name     = "%[1]s-1"
+    policies = ["dev"]
+    metadata = {
+      team = "a"
+    }
+  }
+
+  entity {
+    name     = "%[1]s-2"
+    disabled = true
+  }
+}
+`, prefix)
+}
@@ -32,12 +32,13 @@ func identityEntityPoliciesResource() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
-				Description: "Should the resource manage policies exclusively",
+				Description: "Should the resource manage policies exclusively? Beware of race conditions when disabling exclusive management",
 			},
 
 			"entity_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "ID of the entity.",
 			},
 
@@ -127,7 +128,7 @@ func identityEntityPoliciesRead(d *schema.ResourceData, meta interface{}) error
 	} else {
 		userPolicies := d.Get("policies").(*schema.Set).List()
 		newPolicies := make([]string, 0)
-		apiPolicies := resp.Data["policies"].([]interface{})
+		apiPolicies, _ := resp.Data["policies"].([]interface{})
 
 		for _, policy := range userPolicies {
 			if found, _ := util.SliceHasElement(apiPolicies, policy); found {
@@ -69,6 +69,67 @@ func TestAccIdentityEntityPoliciesNonExclusive(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntityPoliciesNonExclusive_externalPolicies(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+	resourceName := "vault_identity_entity_policies.dev"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckidentityEntityPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityPoliciesConfigNonExclusiveSingle(entity, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityPoliciesCheckLogical("vault_identity_entity.entity", []string{"dev"}),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+				),
+			},
+			{
+				// policies managed elsewhere, e.g. by another module, must be kept.
+				PreConfig: func() {
+					testAccIdentityEntityPoliciesAddPolicy(t, entity, "external")
+				},
+				Config: testAccIdentityEntityPoliciesConfigNonExclusiveSingle(entity, "dev-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityPoliciesCheckLogical("vault_identity_entity.entity", []string{"dev-updated", "external"}),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policies.0", "dev-updated"),
+				),
+			},
+			{
+				// the exclusive mode takes over all of the entity's policies.
+				Config: testAccIdentityEntityPoliciesConfigExclusive(entity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityPoliciesCheckLogical("vault_identity_entity.entity", []string{"test"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityEntityPoliciesAddPolicy(t *testing.T, name, policy string) {
+	t.Helper()
+
+	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+	path := entity.RootEntityPath + "/name/" + name
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		t.Fatalf("error reading entity %q: %s", name, err)
+	}
+	if resp == nil {
+		t.Fatalf("entity %q not found", name)
+	}
+
+	policies, _ := resp.Data["policies"].([]interface{})
+	policies = append(policies, policy)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"policies": policies,
+	}); err != nil {
+		t.Fatalf("error adding policy %q to entity %q: %s", policy, name, err)
+	}
+}
+
 func testAccCheckidentityEntityPoliciesDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_identity_entity_policies" {
@@ -269,3 +330,18 @@ resource "vault_identity_entity_policies" "test" {
 }
 `, entity)
 }
+
+func testAccIdentityEntityPoliciesConfigNonExclusiveSingle(entity, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name              = "%s"
+  external_policies = true
+}
+
+resource "vault_identity_entity_policies" "dev" {
+  entity_id = vault_identity_entity.entity.id
+  exclusive = false
+  policies  = ["%s"]
+}
+`, entity, policy)
+}
@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -25,6 +26,7 @@ func identityGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: identityGroupCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -75,7 +77,7 @@ func identityGroupResource() *schema.Resource {
 				// Suppress the diff if group type is "external" because we cannot manage
 				// group members
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if d.Get("type").(string) == "external" {
+					if d.Get("type").(string) == "external" || d.Get("external_member_group_ids").(bool) == true {
 						return true
 					}
 					return false
@@ -104,10 +106,36 @@ func identityGroupResource() *schema.Resource {
 				Default:     false,
 				Description: "Manage member entities externally through `vault_identity_group_policies_member_entity_ids`",
 			},
+
+			"external_member_group_ids": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage member groups externally through `vault_identity_group_member_group_ids`",
+			},
 		},
 	}
 }
 
+// identityGroupCustomizeDiff rejects member IDs on external groups, their
+// membership is derived from the group's aliases instead.
+func identityGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("type").(string) != "external" {
+		return nil
+	}
+
+	for _, k := range []string{"member_entity_ids", "member_group_ids"} {
+		v := d.GetRawConfig().GetAttr(k)
+		if v.IsNull() || (v.IsKnown() && v.LengthInt() == 0) {
+			continue
+		}
+		return fmt.Errorf("%q can not be set on external groups, "+
+			"their membership is managed through group aliases", k)
+	}
+
+	return nil
+}
+
 func identityGroupUpdateFields(d *schema.ResourceData, data map[string]interface{}) error {
 	if d.IsNewResource() {
 		if name, ok := d.GetOk("name"); ok {
@@ -120,7 +148,9 @@ func identityGroupUpdateFields(d *schema.ResourceData, data map[string]interface
 
 		// Member groups and entities can't be set for external groups
 		if d.Get("type").(string) == "internal" {
-			data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+			if externalMemberGroupIds, ok := d.GetOk("external_member_group_ids"); !(ok && externalMemberGroupIds.(bool)) {
+				data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+			}
 
 			if externalMemberEntityIds, ok := d.GetOk("external_member_entity_ids"); !(ok && externalMemberEntityIds.(bool)) {
 				data["member_entity_ids"] = d.Get("member_entity_ids").(*schema.Set).List()
@@ -137,7 +167,9 @@ func identityGroupUpdateFields(d *schema.ResourceData, data map[string]interface
 			data["policies"] = d.Get("policies").(*schema.Set).List()
 			// Member groups and entities can't be set for external groups
 			if d.Get("type").(string) == "internal" {
-				data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+				if !d.Get("external_member_group_ids").(bool) {
+					data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+				}
 				if !d.Get("external_member_entity_ids").(bool) {
 					data["member_entity_ids"] = d.Get("member_entity_ids").(*schema.Set).List()
 				}
@@ -340,6 +372,18 @@ func readIdentityGroupMemberEntityIds(client *api.Client, groupID string, retry
 	return make([]interface{}, 0), nil
 }
 
+func readIdentityGroupMemberGroupIds(client *api.Client, groupID string, retry bool) ([]interface{}, error) {
+	resp, err := readIdentityGroup(client, groupID, retry)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := resp.Data["member_group_ids"]; ok && v != nil {
+		return v.([]interface{}), nil
+	}
+	return make([]interface{}, 0), nil
+}
+
 // This function may return `nil` for the IdentityGroup if it does not exist
 func readIdentityGroup(client *api.Client, groupID string, retry bool) (*api.Secret, error) {
 	path := identityGroupIDPath(groupID)
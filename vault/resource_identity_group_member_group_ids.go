@@ -0,0 +1,223 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func identityGroupMemberGroupIdsResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityGroupMemberGroupIdsUpdate,
+		Update: identityGroupMemberGroupIdsUpdate,
+		Read:   identityGroupMemberGroupIdsRead,
+		Delete: identityGroupMemberGroupIdsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"member_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Group IDs to be assigned as group members.",
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: `Should the resource manage member group ids 
+exclusively? Beware of race conditions when disabling exclusive management`,
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the group.",
+			},
+		},
+	}
+}
+
+func identityGroupMemberGroupIdsUpdate(d *schema.ResourceData, meta interface{}) error {
+	gid := d.Get("group_id").(string)
+	path := identityGroupIDPath(gid)
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	log.Printf("[DEBUG] Updating IdentityGroupMemberGroupIds %q", gid)
+
+	data := make(map[string]interface{})
+	resp, err := readIdentityGroup(client, gid, d.IsNewResource())
+	if err != nil {
+		return err
+	}
+
+	var curIDS []interface{}
+	if t, ok := resp.Data["type"]; ok && t.(string) != "external" {
+		if v, ok := resp.Data["member_group_ids"]; ok && v != nil {
+			curIDS = v.([]interface{})
+		}
+
+		if d.Get("exclusive").(bool) || len(curIDS) == 0 {
+			data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+		} else {
+			set := map[interface{}]bool{}
+			for _, v := range curIDS {
+				set[v] = true
+			}
+
+			o, _ := d.GetChange("member_group_ids")
+			if !d.IsNewResource() && o != nil {
+				// set.delete()
+				for _, i := range o.(*schema.Set).List() {
+					delete(set, i)
+				}
+			}
+
+			if ids, ok := d.GetOk("member_group_ids"); ok {
+				for _, id := range ids.(*schema.Set).List() {
+					// set.add()
+					set[id] = true
+				}
+			}
+
+			// set.keys()
+			var result []interface{}
+			for k := range set {
+				result = append(result, k)
+			}
+			data["member_group_ids"] = result
+		}
+	}
+
+	_, err = client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating IdentityGroupMemberGroupIds %q: %s", gid, err)
+	}
+	log.Printf("[DEBUG] Updated IdentityGroupMemberGroupIds %q", gid)
+
+	d.SetId(gid)
+
+	return identityGroupMemberGroupIdsRead(d, meta)
+}
+
+func identityGroupMemberGroupIdsRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Read IdentityGroupMemberGroupIds %s", id)
+	resp, err := readIdentityGroup(client, id, d.IsNewResource())
+	if err != nil {
+		if isIdentityNotFoundError(err) {
+			log.Printf("[WARN] IdentityGroupMemberGroupIds %q not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if err := d.Set("group_id", id); err != nil {
+		return err
+	}
+
+	curIDS := resp.Data["member_group_ids"]
+	if d.Get("exclusive").(bool) {
+		if err = d.Set("member_group_ids", curIDS); err != nil {
+			return err
+		}
+	} else {
+		set := map[interface{}]bool{}
+		if curIDS != nil {
+			for _, v := range curIDS.([]interface{}) {
+				set[v] = true
+			}
+		}
+
+		var result []interface{}
+		// set.intersection()
+		if i, ok := d.GetOk("member_group_ids"); ok {
+			for _, v := range i.(*schema.Set).List() {
+				if _, ok := set[v]; ok {
+					result = append(result, v)
+				}
+			}
+		}
+		if err = d.Set("member_group_ids", result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func identityGroupMemberGroupIdsDelete(d *schema.ResourceData, meta interface{}) error {
+	id := d.Get("group_id").(string)
+	path := identityGroupIDPath(id)
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	log.Printf("[DEBUG] Deleting IdentityGroupMemberGroupIds %q", id)
+
+	data := make(map[string]interface{})
+
+	resp, err := readIdentityGroup(client, id, false)
+	if err != nil {
+		if isIdentityNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	t, ok := resp.Data["type"]
+	if ok && t != "external" {
+		if d.Get("exclusive").(bool) {
+			data["member_group_ids"] = make([]string, 0)
+		} else {
+			set := map[interface{}]bool{}
+			if v, ok := resp.Data["member_group_ids"]; ok && v != nil {
+				for _, id := range v.([]interface{}) {
+					set[id] = true
+				}
+			}
+
+			result := []interface{}{}
+			if len(set) > 0 {
+				if v, ok := d.GetOk("member_group_ids"); ok {
+					for _, id := range v.(*schema.Set).List() {
+						delete(set, id)
+					}
+				}
+
+				for k := range set {
+					result = append(result, k)
+				}
+			}
+			data["member_group_ids"] = result
+		}
+	}
+
+	_, err = client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating IdentityGroupMemberGroupIds %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Updated IdentityGroupMemberGroupIds %q", id)
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccIdentityGroupMemberGroupIdsExclusive(t *testing.T) {
+	groupName := acctest.RandomWithPrefix("group")
+	resourceName := "vault_identity_group_member_group_ids.member_group_ids"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupMemberGroupIdsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigExclusive(groupName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member_group_ids.#", "1"),
+					testAccIdentityGroupMemberGroupIdsCheckAPI(resourceName, 1),
+				),
+			},
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigExclusive(groupName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member_group_ids.#", "2"),
+					testAccIdentityGroupMemberGroupIdsCheckAPI(resourceName, 2),
+				),
+			},
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigExclusive(groupName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "member_group_ids.#", "0"),
+					testAccIdentityGroupMemberGroupIdsCheckAPI(resourceName, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityGroupMemberGroupIdsNonExclusive(t *testing.T) {
+	groupName := acctest.RandomWithPrefix("group")
+	resourceNameDev := "vault_identity_group_member_group_ids.dev"
+	resourceNameTest := "vault_identity_group_member_group_ids.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupMemberGroupIdsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigNonExclusive(groupName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameDev, "member_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameTest, "member_group_ids.#", "1"),
+					testAccIdentityGroupMemberGroupIdsCheckAPI(resourceNameDev, 2),
+				),
+			},
+			{
+				// removing the test members must leave the dev members in place
+				Config: testAccIdentityGroupMemberGroupIdsConfigNonExclusive(groupName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameDev, "member_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceNameDev, "member_group_ids.0", "vault_identity_group.dev", "id"),
+					testAccIdentityGroupMemberGroupIdsCheckAPI(resourceNameDev, 1),
+				),
+			},
+		},
+	})
+}
+
+// testAccIdentityGroupMemberGroupIdsCheckAPI checks the number of member
+// groups assigned to the group in Vault.
+func testAccIdentityGroupMemberGroupIdsCheckAPI(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		client, err := provider.GetClient(rs.Primary, testProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		ids, err := readIdentityGroupMemberGroupIds(client, rs.Primary.ID, false)
+		if err != nil {
+			return err
+		}
+
+		if len(ids) != expected {
+			return fmt.Errorf("expected %d member group ids, got %d: %v", expected, len(ids), ids)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityGroupMemberGroupIdsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_group_member_group_ids" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		if _, err := readIdentityGroup(client, rs.Primary.ID, false); err != nil {
+			if isIdentityNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+
+		apiMemberGroupIds, err := readIdentityGroupMemberGroupIds(client, rs.Primary.ID, false)
+		if err != nil {
+			return err
+		}
+		length := rs.Primary.Attributes["member_group_ids.#"]
+
+		if length != "" {
+			count, err := strconv.Atoi(length)
+			if err != nil {
+				return fmt.Errorf("expected %s.# to be a number, got %q", "member_group_ids.#", length)
+			}
+
+			for i := 0; i < count; i++ {
+				memberGroupID := rs.Primary.Attributes["member_group_ids."+strconv.Itoa(i)]
+				if found, _ := util.SliceHasElement(apiMemberGroupIds, memberGroupID); found {
+					return fmt.Errorf("identity group %s still has member group id %s", rs.Primary.ID, memberGroupID)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func testAccIdentityGroupMemberGroupIdsConfigExclusive(groupName string, count int) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name                      = "%s"
+  external_member_group_ids = true
+}
+
+resource "vault_identity_group" "member" {
+  count = %d
+  name  = "%s-member-${count.index}"
+}
+
+resource "vault_identity_group_member_group_ids" "member_group_ids" {
+  group_id         = vault_identity_group.group.id
+  member_group_ids = vault_identity_group.member.*.id
+}
+`, groupName, count, groupName)
+}
+
+func testAccIdentityGroupMemberGroupIdsConfigNonExclusive(groupName string, withTest bool) string {
+	config := fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name                      = "%s"
+  external_member_group_ids = true
+}
+
+resource "vault_identity_group" "dev" {
+  name = "%s-dev"
+}
+
+resource "vault_identity_group_member_group_ids" "dev" {
+  group_id         = vault_identity_group.group.id
+  exclusive        = false
+  member_group_ids = [vault_identity_group.dev.id]
+}
+`, groupName, groupName)
+
+	if withTest {
+		config += fmt.Sprintf(`
+resource "vault_identity_group" "test" {
+  name = "%s-test"
+}
+
+resource "vault_identity_group_member_group_ids" "test" {
+  group_id         = vault_identity_group.group.id
+  exclusive        = false
+  member_group_ids = [vault_identity_group.test.id]
+}
+`, groupName)
+	}
+
+	return config
+}
@@ -37,6 +37,7 @@ func identityGroupPoliciesResource() *schema.Resource {
 			"group_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "ID of the group.",
 			},
 
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -74,6 +75,81 @@ func TestAccIdentityGroupPoliciesNonExclusive(t *testing.T) {
 	})
 }
 
+func TestAccIdentityGroupPoliciesNonExclusive_externalGroup(t *testing.T) {
+	groupName := acctest.RandomWithPrefix("group")
+	resourceNameTeamA := "vault_identity_group_policies.team_a"
+	resourceNameTeamB := "vault_identity_group_policies.team_b"
+	resourceNameGroup := "vault_identity_group.group"
+
+	// outsidePolicy is attached to the group outside of Terraform, it must
+	// never be removed by a non-exclusive resource.
+	outsidePolicy := "outside"
+	var groupID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckidentityGroupPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupPoliciesConfigExternalGroup(groupName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameGroup, "type", "external"),
+					resource.TestCheckResourceAttr(resourceNameTeamA, "policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameTeamB, "policies.#", "2"),
+					testAccIdentityGroupPoliciesCheckAttrs(resourceNameTeamA),
+					testAccIdentityGroupPoliciesCheckAttrs(resourceNameTeamB),
+					func(s *terraform.State) error {
+						rs, err := testutil.GetResourceFromRootModule(s, resourceNameGroup)
+						if err != nil {
+							return err
+						}
+						groupID = rs.Primary.ID
+
+						client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+						policies, err := readIdentityGroupPolicies(client, groupID, false)
+						if err != nil {
+							return err
+						}
+						_, err = client.Logical().Write(identityGroupIDPath(groupID), map[string]interface{}{
+							"policies": append(policies, outsidePolicy),
+						})
+						return err
+					},
+				),
+			},
+			{
+				// team_b is removed, only its own policies must be removed from the group.
+				Config: testAccIdentityGroupPoliciesConfigExternalGroup(groupName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameTeamA, "policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameTeamA, "policies.0", "team-a"),
+					testAccIdentityGroupPoliciesCheckAttrs(resourceNameTeamA),
+					func(s *terraform.State) error {
+						client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+						policies, err := readIdentityGroupPolicies(client, groupID, false)
+						if err != nil {
+							return err
+						}
+
+						for _, p := range []string{"team-a", outsidePolicy} {
+							if found, _ := util.SliceHasElement(policies, p); !found {
+								return fmt.Errorf("expected policy %q on group %s, got %v", p, groupID, policies)
+							}
+						}
+						for _, p := range []string{"team-b", "team-b-admin"} {
+							if found, _ := util.SliceHasElement(policies, p); found {
+								return fmt.Errorf("expected policy %q to be removed from group %s, got %v", p, groupID, policies)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckidentityGroupPoliciesDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_identity_group_policies" {
@@ -226,3 +302,31 @@ resource "vault_identity_group_policies" "test" {
 }
 `)
 }
+
+func testAccIdentityGroupPoliciesConfigExternalGroup(groupName string, withTeamB bool) string {
+	config := fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name              = "%s"
+  type              = "external"
+  external_policies = true
+}
+
+resource "vault_identity_group_policies" "team_a" {
+  group_id  = vault_identity_group.group.id
+  exclusive = false
+  policies  = ["team-a"]
+}
+`, groupName)
+
+	if withTeamB {
+		config += `
+resource "vault_identity_group_policies" "team_b" {
+  group_id  = vault_identity_group.group.id
+  exclusive = false
+  policies  = ["team-b", "team-b-admin"]
+}
+`
+	}
+
+	return config
+}
@@ -87,14 +87,8 @@ func TestAccIdentityGroupUpdate(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccIdentityGroupConfigExternalMembers(group),
-				Check: resource.ComposeTestCheckFunc(
-					testAccIdentityGroupCheckAttrs(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "type", "external"),
-					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "member_entity_ids.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "member_group_ids.#", "0"),
-				),
+				Config:      testAccIdentityGroupConfigExternalMembers(group),
+				ExpectError: regexp.MustCompile(`"member_entity_ids" can not be set on external groups`),
 			},
 		},
 	})
@@ -0,0 +1,141 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	identityMFATOTPAdminGeneratePath = "identity/mfa/method/totp/admin-generate"
+	identityMFATOTPAdminDestroyPath  = "identity/mfa/method/totp/admin-destroy"
+)
+
+var identityMFATOTPAdminSecretIDRegex = regexp.MustCompile("^([^/]+)/([^/]+)$")
+
+func identityMFATOTPAdminSecretResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: identityMFATOTPAdminSecretCreate,
+		ReadContext:   identityMFATOTPAdminSecretRead,
+		DeleteContext: identityMFATOTPAdminSecretDelete,
+
+		Schema: map[string]*schema.Schema{
+			"method_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the TOTP MFA method.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"entity_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID of the entity to generate the TOTP secret for.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"barcode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded PNG QR code of the TOTP secret.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The otpauth URL of the TOTP secret.",
+			},
+		},
+	}
+}
+
+func identityMFATOTPAdminSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	methodID := d.Get("method_id").(string)
+	entityID := d.Get("entity_id").(string)
+
+	log.Printf("[DEBUG] Generating TOTP secret for entity %q on MFA method %q", entityID, methodID)
+	resp, err := client.Logical().WriteWithContext(ctx, identityMFATOTPAdminGeneratePath, map[string]interface{}{
+		"method_id": methodID,
+		"entity_id": entityID,
+	})
+	if err != nil {
+		return diag.Errorf("error generating TOTP secret for entity %q on MFA method %q: %s", entityID, methodID, err)
+	}
+	log.Printf("[DEBUG] Generated TOTP secret for entity %q on MFA method %q", entityID, methodID)
+
+	// Vault only generates a secret once, it returns a warning instead if the
+	// entity already has one.
+	if resp == nil || resp.Data["url"] == nil {
+		var warnings []string
+		if resp != nil {
+			warnings = resp.Warnings
+		}
+		return diag.Errorf("no TOTP secret generated for entity %q on MFA method %q, "+
+			"the entity may already have one: %v", entityID, methodID, warnings)
+	}
+
+	for _, k := range []string{"barcode", "url"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	d.SetId(methodID + "/" + entityID)
+
+	return identityMFATOTPAdminSecretRead(ctx, d, meta)
+}
+
+// identityMFATOTPAdminSecretRead only validates the ID, the TOTP secret cannot
+// be read back from Vault.
+func identityMFATOTPAdminSecretRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	if _, _, err := identityMFATOTPAdminSecretFromID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func identityMFATOTPAdminSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	methodID, entityID, err := identityMFATOTPAdminSecretFromID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Destroying TOTP secret for entity %q on MFA method %q", entityID, methodID)
+	if _, err := client.Logical().WriteWithContext(ctx, identityMFATOTPAdminDestroyPath, map[string]interface{}{
+		"method_id": methodID,
+		"entity_id": entityID,
+	}); err != nil {
+		return diag.Errorf("error destroying TOTP secret for entity %q on MFA method %q: %s", entityID, methodID, err)
+	}
+	log.Printf("[DEBUG] Destroyed TOTP secret for entity %q on MFA method %q", entityID, methodID)
+
+	return nil
+}
+
+func identityMFATOTPAdminSecretFromID(id string) (string, string, error) {
+	res := identityMFATOTPAdminSecretIDRegex.FindStringSubmatch(id)
+	if len(res) != 3 {
+		return "", "", fmt.Errorf("invalid ID %q for TOTP MFA admin secret, must be {method_id}/{entity_id}", id)
+	}
+
+	return res[1], res[2], nil
+}
@@ -0,0 +1,148 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccIdentityMFATOTPAdminSecret_basic(t *testing.T) {
+	entity := acctest.RandomWithPrefix("tf-test-entity")
+	resourceName := "vault_identity_mfa_totp_admin_secret.test"
+
+	var methodID string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccIdentityMFATOTPAdminSecretCheckDestroy(&methodID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMFATOTPAdminSecretConfig(entity),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "method_id",
+						"vault_generic_endpoint.totp", "write_data.method_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "entity_id",
+						"vault_identity_entity.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(`^otpauth://totp/`)),
+					resource.TestCheckResourceAttrSet(resourceName, "barcode"),
+					testAccIdentityMFATOTPAdminSecretSaveMethodID(resourceName, &methodID),
+				),
+			},
+		},
+	})
+}
+
+func Test_identityMFATOTPAdminSecretFromID(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		wantMethodID string
+		wantEntityID string
+		wantErr      bool
+	}{
+		{
+			name:         "valid",
+			id:           "method/entity",
+			wantMethodID: "method",
+			wantEntityID: "entity",
+		},
+		{
+			name:    "missing-entity",
+			id:      "method/",
+			wantErr: true,
+		},
+		{
+			name:    "too-many-parts",
+			id:      "method/entity/extra",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			id:      "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			methodID, entityID, err := identityMFATOTPAdminSecretFromID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("identityMFATOTPAdminSecretFromID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if methodID != tt.wantMethodID {
+				t.Errorf("identityMFATOTPAdminSecretFromID() methodID = %v, want %v", methodID, tt.wantMethodID)
+			}
+			if entityID != tt.wantEntityID {
+				t.Errorf("identityMFATOTPAdminSecretFromID() entityID = %v, want %v", entityID, tt.wantEntityID)
+			}
+		})
+	}
+}
+
+func testAccIdentityMFATOTPAdminSecretSaveMethodID(resourceName string, methodID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		*methodID = rs.Primary.Attributes["method_id"]
+		return nil
+	}
+}
+
+// testAccIdentityMFATOTPAdminSecretCheckDestroy deletes the TOTP MFA method
+// created by the test, vault_generic_endpoint can not delete it since its ID
+// is only known after it has been written.
+func testAccIdentityMFATOTPAdminSecretCheckDestroy(methodID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *methodID == "" {
+			return nil
+		}
+
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+		path := "identity/mfa/method/totp/" + *methodID
+		if _, err := client.Logical().Delete(path); err != nil {
+			return fmt.Errorf("error deleting TOTP MFA method %q: %w", path, err)
+		}
+
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading TOTP MFA method %q: %w", path, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("TOTP MFA method %q still exists", path)
+		}
+
+		return nil
+	}
+}
+
+func testAccIdentityMFATOTPAdminSecretConfig(entity string) string {
+	return fmt.Sprintf(`
+resource "vault_generic_endpoint" "totp" {
+  path           = "identity/mfa/method/totp"
+  disable_read   = true
+  disable_delete = true
+  write_fields   = ["method_id"]
+  data_json      = jsonencode({
+    issuer = "vault"
+  })
+}
+
+resource "vault_identity_entity" "test" {
+  name = "%s"
+}
+
+resource "vault_identity_mfa_totp_admin_secret" "test" {
+  method_id = vault_generic_endpoint.totp.write_data.method_id
+  entity_id = vault_identity_entity.test.id
+}
+`, entity)
+}
@@ -1,8 +1,11 @@
 package vault
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -11,6 +14,8 @@ import (
 
 const identityOidcRolePathTemplate = "identity/oidc/role/%s"
 
+var identityOidcTemplatePlaceholderRegex = regexp.MustCompile(`{{[^}]*}}`)
+
 func identityOidcRole() *schema.Resource {
 	return &schema.Resource{
 		Create: identityOidcRoleCreate,
@@ -38,9 +43,14 @@ func identityOidcRole() *schema.Resource {
 			},
 
 			"template": {
-				Type:        schema.TypeString,
-				Description: "The template string to use for generating tokens. This may be in string-ified JSON or base64 format.",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "The template string to use for generating tokens. This may be in string-ified JSON or base64 format.",
+				Optional:     true,
+				ValidateFunc: validateIdentityOidcTemplate,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Vault stores base64 encoded templates decoded.
+					return old == decodeIdentityOidcTemplate(new)
+				},
 			},
 
 			"ttl": {
@@ -183,6 +193,39 @@ func identityOidcRoleExists(d *schema.ResourceData, meta interface{}) (bool, err
 	return resp != nil, nil
 }
 
+// decodeIdentityOidcTemplate returns the template as Vault stores it, i.e.
+// base64 encoded templates are decoded.
+func decodeIdentityOidcTemplate(template string) string {
+	if decoded, err := base64.StdEncoding.DecodeString(template); err == nil {
+		return string(decoded)
+	}
+	return template
+}
+
+// validateIdentityOidcTemplate ensures the template is valid JSON once any
+// placeholders are rendered. Placeholders may appear unquoted, e.g.
+// {"groups": {{identity.entity.groups.names}}}, so they are replaced with null
+// before the template is parsed.
+func validateIdentityOidcTemplate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if v == "" {
+		return nil, nil
+	}
+
+	rendered := identityOidcTemplatePlaceholderRegex.ReplaceAllString(decodeIdentityOidcTemplate(v), "null")
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(rendered), &obj); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a JSON object or base64 encoded JSON object: %s", k, err)}
+	}
+
+	return nil, nil
+}
+
 func identityOidcRolePath(name string) string {
 	return fmt.Sprintf(identityOidcRolePathTemplate, name)
 }
@@ -1,7 +1,9 @@
 package vault
 
 import (
+	"encoding/base64"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -115,6 +117,75 @@ func TestAccIdentityOidcRoleUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIdentityOidcRole_base64Template(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-role")
+	clientID := acctest.RandomWithPrefix("test-client-id")
+
+	resourceName := "vault_identity_oidc_role.role"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcRoleConfigBase64Template(name, clientID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "template", fmt.Sprintf("%s\n", testAccIdentityOidcRoleTemplate)),
+					resource.TestCheckResourceAttr(resourceName, "client_id", clientID),
+					testAccIdentityOidcRoleCheckAttrs(resourceName),
+				),
+			},
+			{
+				Config:      testAccIdentityOidcRoleConfigInvalidTemplate(name),
+				ExpectError: regexp.MustCompile(`expected template to be a JSON object`),
+			},
+		},
+	})
+}
+
+func TestValidateIdentityOidcTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{
+			name:     "empty",
+			template: "",
+		},
+		{
+			name:     "json",
+			template: `{"name": "{{identity.entity.name}}"}`,
+		},
+		{
+			name:     "unquoted-placeholder",
+			template: testAccIdentityOidcRoleTemplate,
+		},
+		{
+			name:     "base64",
+			template: base64.StdEncoding.EncodeToString([]byte(testAccIdentityOidcRoleTemplate)),
+		},
+		{
+			name:     "invalid",
+			template: `{"name": }`,
+			wantErr:  true,
+		},
+		{
+			name:     "not-an-object",
+			template: `["foo"]`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateIdentityOidcTemplate(tt.template, "template")
+			if tt.wantErr != (len(errs) > 0) {
+				t.Errorf("validateIdentityOidcTemplate() errs = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
 func testAccCheckIdentityOidcRoleDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_identity_oidc_role" {
@@ -220,3 +291,35 @@ EOF
 	ttl = 3600
 }`, entityName, entityName, clientId, testAccIdentityOidcRoleTemplate)
 }
+
+func testAccIdentityOidcRoleConfigBase64Template(entityName, clientID string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name      = "%s"
+  algorithm = "RS256"
+}
+
+resource "vault_identity_oidc_role" "role" {
+  name      = "%s"
+  key       = vault_identity_oidc_key.key.name
+  client_id = "%s"
+  template  = base64encode(<<EOF
+%s
+EOF
+  )
+}`, entityName, entityName, clientID, testAccIdentityOidcRoleTemplate)
+}
+
+func testAccIdentityOidcRoleConfigInvalidTemplate(entityName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name      = "%s"
+  algorithm = "RS256"
+}
+
+resource "vault_identity_oidc_role" "role" {
+  name     = "%s"
+  key      = vault_identity_oidc_key.key.name
+  template = "{\"name\": }"
+}`, entityName, entityName)
+}
@@ -287,9 +287,7 @@ func jwtAuthBackendRoleRead(_ context.Context, d *schema.ResourceData, meta inte
 	d.Set("backend", backend)
 	d.Set("role_name", role)
 
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
-
-	return diags
+	return nil
 }
 
 func jwtAuthBackendRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
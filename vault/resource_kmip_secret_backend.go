@@ -10,9 +10,12 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
+var kmipKeyTypes = []string{"rsa", "ec"}
+
 var kmipAPIFields = []string{
 	"default_tls_client_key_bits",
 	"default_tls_client_key_type",
@@ -70,10 +73,11 @@ func kmipSecretBackendResource() *schema.Resource {
 			},
 
 			"tls_ca_key_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "CA key type, rsa or ec",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(kmipKeyTypes, false),
+				Description:  "CA key type, rsa or ec",
 			},
 			"tls_ca_key_bits": {
 				Type:        schema.TypeInt,
@@ -82,16 +86,18 @@ func kmipSecretBackendResource() *schema.Resource {
 				Description: "CA key bits, valid values depend on key type",
 			},
 			"tls_min_version": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Minimum TLS version to accept",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tls12", "tls13"}, false),
+				Description:  "Minimum TLS version to accept",
 			},
 			"default_tls_client_key_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Client certificate key type, rsa or ec",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(kmipKeyTypes, false),
+				Description:  "Client certificate key type, rsa or ec",
 			},
 			"default_tls_client_key_bits": {
 				Type:        schema.TypeInt,
@@ -145,11 +151,8 @@ func kmipSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		src := path
 		dest := d.Get("path").(string)
 
-		log.Printf("[DEBUG] Remount %s to %s in Vault", src, dest)
-
-		err := client.Sys().Remount(src, dest)
-		if err != nil {
-			return fmt.Errorf("error remounting in Vault: %s", err)
+		if err := util.Remount(client, src, dest, util.RemountTimeout); err != nil {
+			return err
 		}
 
 		// There is something similar in resource_mount.go, but in the call to TuneMount().
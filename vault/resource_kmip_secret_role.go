@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -77,9 +78,10 @@ func kmipSecretRoleResource() *schema.Resource {
 				Description: "Name of the role",
 			},
 			fieldTLSClientKeyType: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Client certificate key type, rsa or ec",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kmipKeyTypes, false),
+				Description:  "Client certificate key type, rsa or ec",
 			},
 			fieldTLSClientKeyBits: {
 				Type:        schema.TypeInt,
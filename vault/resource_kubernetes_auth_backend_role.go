@@ -205,9 +205,7 @@ func kubernetesAuthBackendRoleRead(_ context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
-
-	return diags
+	return nil
 }
 
 func kubernetesAuthBackendRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -3,6 +3,7 @@ package vault
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,8 +11,11 @@ import (
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
+const kvV2ConfigSuffix = "/config"
+
 func kvSecretBackendV2Resource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: kvSecretBackendV2CreateUpdate,
@@ -46,7 +50,7 @@ func kvSecretBackendV2Resource() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Description: "If set, specifies the length of time before " +
-					"a version is deleted",
+					"a version is deleted, in seconds.",
 			},
 		},
 	}
@@ -67,10 +71,12 @@ func kvSecretBackendV2CreateUpdate(ctx context.Context, d *schema.ResourceData,
 		data[k] = d.Get(k)
 	}
 
-	path := mount + "/config"
+	path := kvSecretBackendV2ConfigPath(mount)
+	log.Printf("[DEBUG] Writing KV-V2 config to %q", path)
 	if _, err := client.Logical().Write(path, data); err != nil {
 		return diag.Errorf("error writing config data to %s, err=%s", path, err)
 	}
+	log.Printf("[DEBUG] Wrote KV-V2 config to %q", path)
 
 	d.SetId(path)
 
@@ -98,6 +104,11 @@ func kvSecretBackendV2Read(_ context.Context, d *schema.ResourceData, meta inter
 		return nil
 	}
 
+	// the mount is derived from the ID, so that it is also set on import.
+	if err := d.Set(consts.FieldMount, strings.TrimSuffix(path, kvV2ConfigSuffix)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	configFields := []string{"max_versions", "cas_required"}
 	for _, k := range configFields {
 		if err := d.Set(k, config.Data[k]); err != nil {
@@ -120,6 +131,31 @@ func kvSecretBackendV2Read(_ context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
-func kvSecretBackendV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// kvSecretBackendV2Delete resets the config to Vault's defaults,
+// since the config can not be removed from the mount.
+func kvSecretBackendV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+	log.Printf("[DEBUG] Resetting KV-V2 config %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"max_versions":         0,
+		"cas_required":         false,
+		"delete_version_after": 0,
+	}); err != nil {
+		if util.Is404(err) {
+			return nil
+		}
+		return diag.Errorf("error resetting config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Reset KV-V2 config %q", path)
+
 	return nil
 }
+
+func kvSecretBackendV2ConfigPath(mount string) string {
+	return strings.Trim(mount, "/") + kvV2ConfigSuffix
+}
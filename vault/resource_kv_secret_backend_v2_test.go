@@ -1,13 +1,16 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
 
@@ -38,15 +41,46 @@ func TestAccKVSecretBackendV2(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"mount"},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// removing the resource resets the config of the mount.
+				Config: kvV2MountConfig(mount),
+				Check:  testKVSecretBackendV2CheckReset(mount),
 			},
 		},
 	})
 }
 
+func testKVSecretBackendV2CheckReset(mount string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+		path := kvSecretBackendV2ConfigPath(mount)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("config %q not found", path)
+		}
+
+		for k, want := range map[string]interface{}{
+			"max_versions":         json.Number("0"),
+			"cas_required":         false,
+			"delete_version_after": "0s",
+		} {
+			if resp.Data[k] != want {
+				return fmt.Errorf("expected %s to be reset to %v, got %v", k, want, resp.Data[k])
+			}
+		}
+
+		return nil
+	}
+}
+
 func testKVSecretBackendV2Config(path string, isUpdate bool) string {
 	ret := fmt.Sprintf(`
 %s
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -50,7 +52,8 @@ func kvSecretV2Resource(name string) *schema.Resource {
 				Description: "This flag is required if cas_required is set to true " +
 					"on either the secret or the engine's config. In order for a " +
 					"write to be successful, cas must be set to the current version " +
-					"of the secret.",
+					"of the secret, or 0 to only allow the secret to be created.",
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"options": {
 				Type:        schema.TypeMap,
@@ -129,20 +132,45 @@ func kvSecretV2Write(ctx context.Context, d *schema.ResourceData, meta interface
 		"data": secretData,
 	}
 
-	kvFields := []string{"cas", "options"}
-	for _, k := range kvFields {
-		data[k] = d.Get(k)
+	options := map[string]interface{}{}
+	for k, v := range d.Get("options").(map[string]interface{}) {
+		options[k] = v
 	}
+	// cas is sent as part of the options, a cas of 0 is meaningful,
+	// so whether it is set is determined from the raw config.
+	if !d.GetRawConfig().GetAttr("cas").IsNull() {
+		options["cas"] = d.Get("cas").(int)
+	}
+	data["options"] = options
 
+	log.Printf("[DEBUG] Writing KV-V2 secret to %q", path)
 	if _, err := client.Logical().Write(path, data); err != nil {
+		if isKVV2CASErr(err) {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("check-and-set failed writing secret data to %s", path),
+					Detail: fmt.Sprintf("The secret was modified outside of Terraform or cas does not match "+
+						"its current version. Set cas to the current version of the secret, "+
+						"as found in metadata.version, to overwrite it. Error: %s", err),
+				},
+			}
+		}
 		return diag.Errorf("error writing secret data to %s, err=%s", path, err)
 	}
+	log.Printf("[DEBUG] Wrote KV-V2 secret to %q", path)
 
 	d.SetId(path)
 
 	return kvSecretV2Read(ctx, d, meta)
 }
 
+// isKVV2CASErr returns true if the error is due to a check-and-set mismatch,
+// or a missing cas when the secret or mount require it.
+func isKVV2CASErr(err error) bool {
+	return strings.Contains(err.Error(), "check-and-set parameter")
+}
+
 func kvSecretV2Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	shouldRead := !d.Get("disable_read").(bool)
 
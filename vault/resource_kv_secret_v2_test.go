@@ -2,12 +2,15 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
 
@@ -25,7 +28,7 @@ func TestAccKVSecretV2(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
 					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
-					resource.TestCheckResourceAttr(resourceName, "cas", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cas", "0"),
 					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, fmt.Sprintf("%s/data/%s", mount, name)),
 					resource.TestCheckResourceAttr(resourceName, "delete_all_versions", "true"),
 					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
@@ -47,6 +50,142 @@ func TestAccKVSecretV2(t *testing.T) {
 	})
 }
 
+func TestAccKVSecretV2_cas(t *testing.T) {
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2Config_cas(mount, name, 0, "zap"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cas", "0"),
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "1"),
+				),
+			},
+			{
+				Config: testKVSecretV2Config_cas(mount, name, 1, "zop"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cas", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zop"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.version", "2"),
+				),
+			},
+			{
+				// a concurrent modification must not be overwritten.
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+					_, err := client.Logical().Write(getKVV2Path(mount, name, consts.FieldData), map[string]interface{}{
+						"data": map[string]interface{}{
+							"zip": "elsewhere",
+						},
+					})
+					if err != nil {
+						t.Fatalf("unable to manually update the secret via the SDK: %s", err)
+					}
+				},
+				Config:      testKVSecretV2Config_cas(mount, name, 2, "zup"),
+				ExpectError: regexp.MustCompile(`check-and-set failed writing secret data`),
+			},
+		},
+	})
+}
+
+func TestAccKVSecretV2_deleteAllVersions(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2Config_deleteAllVersions(mount, name, false),
+			},
+			{
+				// the secret is soft-deleted, its history is kept.
+				Config: kvV2MountConfig(mount),
+				Check:  testKVSecretV2CheckMetadata(mount, name, true),
+			},
+			{
+				Config: testKVSecretV2Config_deleteAllVersions(mount, name, true),
+			},
+			{
+				// all versions and the metadata are deleted.
+				Config: kvV2MountConfig(mount),
+				Check:  testKVSecretV2CheckMetadata(mount, name, false),
+			},
+		},
+	})
+}
+
+func testKVSecretV2CheckMetadata(mount, name string, expectExists bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+		path := getKVV2Path(mount, name, consts.FieldMetadata)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading %q: %s", path, err)
+		}
+
+		if !expectExists {
+			if resp != nil {
+				return fmt.Errorf("expected the metadata at %q to be deleted", path)
+			}
+			return nil
+		}
+
+		if resp == nil {
+			return fmt.Errorf("expected the metadata at %q to exist", path)
+		}
+
+		versions, ok := resp.Data["versions"].(map[string]interface{})
+		if !ok || len(versions) == 0 {
+			return fmt.Errorf("expected the version history at %q to exist", path)
+		}
+
+		return nil
+	}
+}
+
+func testKVSecretV2Config_deleteAllVersions(mount, name string, deleteAllVersions bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount               = vault_mount.kvv2.path
+  name                = "%s"
+  delete_all_versions = %t
+  data_json = jsonencode(
+    {
+      zip = "zap"
+    }
+  )
+}`, kvV2MountConfig(mount), name, deleteAllVersions)
+}
+
+func testKVSecretV2Config_cas(mount, name string, cas int, value string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  cas       = %d
+  data_json = jsonencode(
+    {
+      zip = "%s"
+    }
+  )
+}`, kvV2MountConfig(mount), name, cas, value)
+}
+
 func testKVSecretV2Config(mount, name string) string {
 	ret := fmt.Sprintf(`
 %s
@@ -57,7 +196,7 @@ func testKVSecretV2Config(mount, name string) string {
 resource "vault_kv_secret_v2" "test" {
   mount                      = vault_mount.kvv2.path
   name                       = "%s"
-  cas                        = 1
+  cas                        = 0
   delete_all_versions        = true
   data_json                  = jsonencode(
   {
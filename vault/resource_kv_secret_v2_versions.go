@@ -0,0 +1,252 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const (
+	kvV2VersionStateActive    = "active"
+	kvV2VersionStateDeleted   = "deleted"
+	kvV2VersionStateDestroyed = "destroyed"
+)
+
+func kvSecretV2VersionsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kvSecretV2VersionsWrite,
+		UpdateContext: kvSecretV2VersionsWrite,
+		DeleteContext: kvSecretV2VersionsDelete,
+		ReadContext:   kvSecretV2VersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data " +
+					"prefix. For example, for a secret at 'kvv2/data/foo/bar/baz', " +
+					"the name is 'foo/bar/baz'",
+			},
+			"versions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The versions of the secret to manage.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The desired state of the versions. One of active, deleted or destroyed. " +
+					"Destroyed versions can not be restored.",
+				ValidateFunc: validation.StringInSlice([]string{
+					kvV2VersionStateActive,
+					kvV2VersionStateDeleted,
+					kvV2VersionStateDestroyed,
+				}, false),
+			},
+			"version_states": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The current state of each managed version, as read from the secret's metadata.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func kvSecretV2VersionsWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+	state := d.Get("state").(string)
+	versions := kvSecretV2VersionsList(d)
+
+	current, err := readKVV2VersionStates(client, mount, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if current == nil {
+		return diag.Errorf("metadata for secret %q not found in mount %q", name, mount)
+	}
+
+	for _, v := range versions {
+		s, ok := current[strconv.Itoa(v)]
+		if !ok {
+			return diag.Errorf("version %d of secret %q does not exist", v, name)
+		}
+		if s == kvV2VersionStateDestroyed && state != kvV2VersionStateDestroyed {
+			return diag.Errorf("version %d of secret %q is destroyed and can not be made %s", v, name, state)
+		}
+	}
+
+	var prefix string
+	switch state {
+	case kvV2VersionStateActive:
+		prefix = "undelete"
+	case kvV2VersionStateDeleted:
+		prefix = "delete"
+	case kvV2VersionStateDestroyed:
+		prefix = "destroy"
+	}
+
+	path := getKVV2Path(mount, name, prefix)
+	log.Printf("[DEBUG] Setting versions %v of KV-V2 secret to %s via %q", versions, state, path)
+	if _, err := client.Logical().WriteWithContext(ctx, path, map[string]interface{}{
+		"versions": versions,
+	}); err != nil {
+		return diag.Errorf("error writing to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Set versions %v of KV-V2 secret to %s via %q", versions, state, path)
+
+	d.SetId(getKVV2Path(mount, name, consts.FieldMetadata))
+
+	return kvSecretV2VersionsRead(ctx, d, meta)
+}
+
+func kvSecretV2VersionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+
+	current, err := readKVV2VersionStates(client, mount, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if current == nil {
+		log.Printf("[WARN] KV-V2 secret metadata %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var states []string
+	versionStates := map[string]string{}
+	for _, v := range kvSecretV2VersionsList(d) {
+		k := strconv.Itoa(v)
+		s, ok := current[k]
+		if !ok {
+			// versions that have been pruned by max_versions no longer exist.
+			continue
+		}
+		states = append(states, s)
+		versionStates[k] = s
+	}
+
+	if err := d.Set("version_states", versionStates); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// only report the state when all managed versions agree on it,
+	// any drift will then be reconciled on the next apply.
+	var state string
+	for i, s := range states {
+		if i == 0 {
+			state = s
+		} else if s != state {
+			state = ""
+			break
+		}
+	}
+
+	if err := d.Set("state", state); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func kvSecretV2VersionsDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing %q from state, the versions of the secret are left as is", d.Id())
+	return nil
+}
+
+func kvSecretV2VersionsList(d *schema.ResourceData) []int {
+	var versions []int
+	for _, v := range d.Get("versions").(*schema.Set).List() {
+		versions = append(versions, v.(int))
+	}
+	sort.Ints(versions)
+
+	return versions
+}
+
+// readKVV2VersionStates returns the state of each version of the secret,
+// keyed on the version number, or nil if the secret's metadata does not exist.
+func readKVV2VersionStates(client *api.Client, mount, name string) (map[string]string, error) {
+	path := getKVV2Path(mount, name, consts.FieldMetadata)
+
+	log.Printf("[DEBUG] Reading KV-V2 secret metadata from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		if util.Is404(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading from %q: %w", path, err)
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	versions, ok := resp.Data["versions"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected versions in response from %q: %#v", path, resp.Data["versions"])
+	}
+
+	result := make(map[string]string, len(versions))
+	for k, v := range versions {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected version %q in response from %q: %#v", k, path, v)
+		}
+		result[k] = kvV2VersionState(m)
+	}
+
+	return result, nil
+}
+
+func kvV2VersionState(m map[string]interface{}) string {
+	if destroyed, _ := m["destroyed"].(bool); destroyed {
+		return kvV2VersionStateDestroyed
+	}
+	// deletion_time may be in the future when delete_version_after is set.
+	if v, _ := m["deletion_time"].(string); v != "" {
+		deletionTime, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil || !deletionTime.After(time.Now()) {
+			return kvV2VersionStateDeleted
+		}
+	}
+
+	return kvV2VersionStateActive
+}
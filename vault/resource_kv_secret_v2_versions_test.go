@@ -0,0 +1,121 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecretV2Versions(t *testing.T) {
+	resourceName := "vault_kv_secret_v2_versions.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2VersionsConfig(mount, name, "deleted"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, name),
+					resource.TestCheckResourceAttr(resourceName, "state", "deleted"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_states.1", "deleted"),
+				),
+			},
+			{
+				Config: testKVSecretV2VersionsConfig(mount, name, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "version_states.1", "active"),
+				),
+			},
+			{
+				Config: testKVSecretV2VersionsConfig(mount, name, "destroyed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "destroyed"),
+					resource.TestCheckResourceAttr(resourceName, "version_states.1", "destroyed"),
+				),
+			},
+		},
+	})
+}
+
+func TestKVV2VersionState(t *testing.T) {
+	tests := []struct {
+		name string
+		m    map[string]interface{}
+		want string
+	}{
+		{
+			name: "active",
+			m: map[string]interface{}{
+				"deletion_time": "",
+				"destroyed":     false,
+			},
+			want: kvV2VersionStateActive,
+		},
+		{
+			name: "deleted",
+			m: map[string]interface{}{
+				"deletion_time": "2018-03-22T02:36:43.986212308Z",
+				"destroyed":     false,
+			},
+			want: kvV2VersionStateDeleted,
+		},
+		{
+			name: "scheduled-deletion",
+			m: map[string]interface{}{
+				"deletion_time": time.Now().Add(time.Hour).Format(time.RFC3339Nano),
+				"destroyed":     false,
+			},
+			want: kvV2VersionStateActive,
+		},
+		{
+			name: "destroyed",
+			m: map[string]interface{}{
+				"deletion_time": "",
+				"destroyed":     true,
+			},
+			want: kvV2VersionStateDestroyed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kvV2VersionState(tt.m); got != tt.want {
+				t.Errorf("kvV2VersionState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testKVSecretV2VersionsConfig(mount, name, state string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  data_json = jsonencode(
+    {
+      foo = "bar"
+    }
+  )
+}
+
+resource "vault_kv_secret_v2_versions" "test" {
+  mount    = vault_mount.kvv2.path
+  name     = vault_kv_secret_v2.test.name
+  versions = [1]
+  state    = "%s"
+}
+`, kvV2MountConfig(mount), name, state)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/vault/api"
 
@@ -160,6 +161,25 @@ func ldapAuthBackendResource() *schema.Resource {
 			Computed:  true,
 			Sensitive: true,
 		},
+		"connection_timeout": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Timeout in seconds when connecting to the LDAP server before trying the next URL in the configuration.",
+		},
+		"max_page_size": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "If set to a value greater than 0, the LDAP backend will use the LDAP server's paged search control to request pages of up to the given size.",
+		},
+		"dereference_aliases": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "When aliases should be dereferenced on search operations. Accepted values are 'never', 'finding', 'searching', 'always'.",
+			ValidateFunc: validation.StringInSlice([]string{"never", "finding", "searching", "always"}, false),
+		},
 	}
 
 	addTokenFields(fields, &addTokenFieldsConfig{})
@@ -303,6 +323,20 @@ func ldapAuthBackendUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		data["client_tls_key"] = v.(string)
 	}
 
+	if v, ok := d.GetOk("connection_timeout"); ok {
+		data["connection_timeout"] = v.(int)
+	}
+
+	// send max_page_size on change, so that it can be set back to 0 to
+	// disable paged searches.
+	if d.HasChange("max_page_size") {
+		data["max_page_size"] = d.Get("max_page_size").(int)
+	}
+
+	if v, ok := d.GetOk("dereference_aliases"); ok {
+		data["dereference_aliases"] = v.(string)
+	}
+
 	updateTokenFields(d, data, false)
 
 	log.Printf("[DEBUG] Writing LDAP config %q", path)
@@ -378,12 +412,17 @@ func ldapAuthBackendRead(_ context.Context, d *schema.ResourceData, meta interfa
 	d.Set("username_as_alias", resp.Data["username_as_alias"])
 	d.Set("use_token_groups", resp.Data["use_token_groups"])
 
-	// `bindpass`, `client_tls_cert` and `client_tls_key` cannot be read out from the API
-	// So... if they drift, they drift.
+	// These fields are only returned by newer versions of Vault.
+	for _, k := range []string{"client_tls_cert", "connection_timeout", "max_page_size", "dereference_aliases"} {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
 
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
+	// `bindpass` and `client_tls_key` cannot be read out from the API
+	// So... if they drift, they drift.
 
-	return diags
+	return nil
 }
 
 func ldapAuthBackendDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
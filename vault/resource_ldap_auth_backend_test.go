@@ -91,6 +91,51 @@ func TestLDAPAuthBackend_tls(t *testing.T) {
 	})
 }
 
+func TestLDAPAuthBackend_connectionFields(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-ldap-path")
+
+	resourceName := "vault_ldap_auth_backend.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		Providers:    testProviders,
+		CheckDestroy: testLDAPAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPAuthBackendConfig_connectionFields(path, 15, 100, "never"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "userfilter", "({{.UserAttr}}={{.Username}})"),
+					resource.TestCheckResourceAttr(resourceName, "connection_timeout", "15"),
+					resource.TestCheckResourceAttr(resourceName, "max_page_size", "100"),
+					resource.TestCheckResourceAttr(resourceName, "dereference_aliases", "never"),
+				),
+			},
+			{
+				Config: testLDAPAuthBackendConfig_connectionFields(path, 30, 500, "always"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "connection_timeout", "30"),
+					resource.TestCheckResourceAttr(resourceName, "max_page_size", "500"),
+					resource.TestCheckResourceAttr(resourceName, "dereference_aliases", "always"),
+				),
+			},
+			{
+				Config: testLDAPAuthBackendConfig_connectionFields(path, 30, 0, "always"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_page_size", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bindpass"},
+			},
+		},
+	})
+}
+
 func testLDAPAuthBackendDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vault_ldap_auth_backend" {
@@ -252,6 +297,21 @@ resource "vault_ldap_auth_backend" "test" {
 `, path, local, use_token_groups)
 }
 
+func testLDAPAuthBackendConfig_connectionFields(path string, connectionTimeout, maxPageSize int, dereferenceAliases string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_auth_backend" "test" {
+    path                = "%s"
+    url                 = "ldaps://example.org"
+    binddn              = "cn=example.com"
+    bindpass            = "supersecurepassword"
+    userfilter          = "({{.UserAttr}}={{.Username}})"
+    connection_timeout  = %d
+    max_page_size       = %d
+    dereference_aliases = "%s"
+}
+`, path, connectionTimeout, maxPageSize, dereferenceAliases)
+}
+
 func testLDAPAuthBackendConfig_tls(path, use_token_groups string, local string) string {
 	return fmt.Sprintf(`
 resource "vault_ldap_auth_backend" "test" {
@@ -0,0 +1,212 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var (
+	ldapSecretBackendDynamicRoleMountFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	ldapSecretBackendDynamicRoleNameFromPathRegex  = regexp.MustCompile("^.+/role/(.+)$")
+
+	ldapSecretBackendDynamicRoleFields = []string{
+		consts.FieldCreationLDIF,
+		consts.FieldDeletionLDIF,
+		consts.FieldRollbackLDIF,
+		"username_template",
+		"default_ttl",
+		"max_ttl",
+	}
+)
+
+func ldapSecretBackendDynamicRoleResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ldapSecretBackendDynamicRoleWrite,
+		ReadContext:   ldapSecretBackendDynamicRoleRead,
+		UpdateContext: ldapSecretBackendDynamicRoleWrite,
+		DeleteContext: ldapSecretBackendDynamicRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     consts.MountTypeLDAP,
+				ForceNew:    true,
+				Description: "The path where the LDAP secrets backend is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the role.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			consts.FieldCreationLDIF: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A templatized LDIF string used to create a user account.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			consts.FieldDeletionLDIF: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A templatized LDIF string used to delete the user account once its TTL has expired.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			consts.FieldRollbackLDIF: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "A templatized LDIF string used to attempt to rollback any changes in the event " +
+					"that execution of the creation_ldif results in an error.",
+			},
+			"username_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "A template used to generate a dynamic username.",
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the TTL for the leases associated with this role, in seconds.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the maximum TTL for the leases associated with this role, in seconds.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func ldapSecretBackendDynamicRoleWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get("role_name").(string)
+	path := ldapSecretBackendDynamicRolePath(mount, name)
+
+	data := map[string]interface{}{}
+	for _, k := range ldapSecretBackendDynamicRoleFields {
+		// rollback_ldif is always sent so that it can be cleared,
+		// the remaining optional fields fall back to Vault's defaults.
+		if v, ok := d.GetOk(k); ok || k == consts.FieldRollbackLDIF {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing LDAP dynamic role %q", path)
+	if _, err := client.Logical().WriteWithContext(ctx, path, data); err != nil {
+		return diag.Errorf("error writing LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP dynamic role %q", path)
+
+	d.SetId(path)
+
+	return ldapSecretBackendDynamicRoleRead(ctx, d, meta)
+}
+
+func ldapSecretBackendDynamicRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+	mount, name, err := ldapSecretBackendDynamicRoleFromPath(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Reading LDAP dynamic role %q", path)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read LDAP dynamic role %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] LDAP dynamic role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// the mount and name are derived from the ID, so that they are also set on import.
+	if err := d.Set(consts.FieldMount, mount); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("role_name", name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range ldapSecretBackendDynamicRoleFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return diag.Errorf("error setting state key %q on LDAP dynamic role %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting LDAP dynamic role %q", path)
+	if _, err := client.Logical().DeleteWithContext(ctx, path); err != nil {
+		if util.Is404(err) {
+			return nil
+		}
+		return diag.Errorf("error deleting LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP dynamic role %q", path)
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRolePath(mount, name string) string {
+	return strings.Trim(mount, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func ldapSecretBackendDynamicRoleFromPath(path string) (string, string, error) {
+	if !ldapSecretBackendDynamicRoleMountFromPathRegex.MatchString(path) {
+		return "", "", fmt.Errorf("no mount found in LDAP dynamic role path %q", path)
+	}
+	if !ldapSecretBackendDynamicRoleNameFromPathRegex.MatchString(path) {
+		return "", "", fmt.Errorf("no role name found in LDAP dynamic role path %q", path)
+	}
+
+	mount := ldapSecretBackendDynamicRoleMountFromPathRegex.FindStringSubmatch(path)[1]
+	name := ldapSecretBackendDynamicRoleNameFromPathRegex.FindStringSubmatch(path)[1]
+
+	return mount, name, nil
+}
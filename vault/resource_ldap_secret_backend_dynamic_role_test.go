@@ -0,0 +1,164 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+const (
+	testLDAPCreationLDIF = `dn: cn={{.Username}},ou=users,dc=example,dc=org
+objectClass: person
+objectClass: top
+cn: {{.Username}}
+sn: {{.Username}}
+userPassword: {{.Password}}
+`
+	testLDAPDeletionLDIF = `dn: cn={{.Username}},ou=users,dc=example,dc=org
+changetype: delete
+`
+)
+
+func TestLDAPSecretBackendDynamicRole(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_ldap_secret_backend_dynamic_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		CheckDestroy: testLDAPSecretBackendDynamicRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendDynamicRoleConfig(mount, name, true, 3600, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, "role_name", name),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCreationLDIF, testLDAPCreationLDIF),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldDeletionLDIF, testLDAPDeletionLDIF),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRollbackLDIF, testLDAPDeletionLDIF),
+					resource.TestCheckResourceAttr(resourceName, "username_template", "v_{{.RoleName}}_{{random 10}}"),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "7200"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testLDAPSecretBackendDynamicRoleConfig(mount, name, false, 60, 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRollbackLDIF, ""),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "120"),
+				),
+			},
+		},
+	})
+}
+
+func testLDAPSecretBackendDynamicRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend_dynamic_role" {
+			continue
+		}
+
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("LDAP dynamic role %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testLDAPSecretBackendDynamicRoleConfig(mount, name string, withRollback bool, defaultTTL, maxTTL int) string {
+	rollback := ""
+	if withRollback {
+		rollback = "rollback_ldif     = local.deletion_ldif"
+	}
+
+	return fmt.Sprintf(`
+locals {
+  creation_ldif = <<EOT
+%sEOT
+  deletion_ldif = <<EOT
+%sEOT
+}
+
+resource "vault_mount" "ldap" {
+  path = "%s"
+  type = "ldap"
+}
+
+resource "vault_ldap_secret_backend_dynamic_role" "test" {
+  mount             = vault_mount.ldap.path
+  role_name         = "%s"
+  creation_ldif     = local.creation_ldif
+  deletion_ldif     = local.deletion_ldif
+  username_template = "v_{{.RoleName}}_{{random 10}}"
+  default_ttl       = %d
+  max_ttl           = %d
+  %s
+}
+`, testLDAPCreationLDIF, testLDAPDeletionLDIF, mount, name, defaultTTL, maxTTL, rollback)
+}
+
+func Test_ldapSecretBackendDynamicRoleFromPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		wantMount string
+		wantName  string
+		wantErr   bool
+	}{
+		{
+			name:      "basic",
+			path:      "ldap/role/example",
+			wantMount: "ldap",
+			wantName:  "example",
+		},
+		{
+			name:      "nested-mount",
+			path:      "ns1/ldap/role/example",
+			wantMount: "ns1/ldap",
+			wantName:  "example",
+		},
+		{
+			name:    "invalid",
+			path:    "ldap/static-role/example",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mount, name, err := ldapSecretBackendDynamicRoleFromPath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ldapSecretBackendDynamicRoleFromPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if mount != tt.wantMount {
+				t.Errorf("ldapSecretBackendDynamicRoleFromPath() mount = %v, want %v", mount, tt.wantMount)
+			}
+			if name != tt.wantName {
+				t.Errorf("ldapSecretBackendDynamicRoleFromPath() name = %v, want %v", name, tt.wantName)
+			}
+		})
+	}
+}
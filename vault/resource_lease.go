@@ -0,0 +1,200 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var leaseLookupFields = []string{
+	"issue_time",
+	"expire_time",
+	"last_renewal",
+	"renewable",
+	"ttl",
+}
+
+func leaseResource() *schema.Resource {
+	return &schema.Resource{
+		Create: leaseCreate,
+		Read:   leaseRead,
+		Update: leaseUpdate,
+		Delete: leaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set(consts.FieldLeaseID, d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldLeaseID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the lease to manage.",
+			},
+			"increment": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The requested amount of time in seconds to extend the lease by. When set, the lease is renewed on creation and whenever this value changes.",
+			},
+			"revoke_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Revoke the lease when the resource is destroyed.",
+			},
+			"issue_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the lease was issued.",
+			},
+			"expire_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the lease will expire.",
+			},
+			"last_renewal": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the lease was last renewed.",
+			},
+			"renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the lease can be renewed.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The remaining TTL of the lease in seconds, as of the last read.",
+			},
+		},
+	}
+}
+
+// lookupLease returns the lease details for leaseID, or nil if Vault does not
+// know about the lease, e.g. because it has expired or been revoked.
+func lookupLease(client *api.Client, leaseID string) (*api.Secret, error) {
+	log.Printf("[DEBUG] Looking up lease %q", leaseID)
+	resp, err := client.Sys().Lookup(leaseID)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid lease") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error looking up lease %q: %w", leaseID, err)
+	}
+	log.Printf("[DEBUG] Looked up lease %q", leaseID)
+
+	return resp, nil
+}
+
+func renewLease(d *schema.ResourceData, client *api.Client) error {
+	leaseID := d.Get(consts.FieldLeaseID).(string)
+	increment := d.Get("increment").(int)
+
+	log.Printf("[DEBUG] Renewing lease %q", leaseID)
+	if _, err := client.Sys().Renew(leaseID, increment); err != nil {
+		return fmt.Errorf("error renewing lease %q: %w", leaseID, err)
+	}
+	log.Printf("[DEBUG] Renewed lease %q", leaseID)
+
+	return nil
+}
+
+func leaseCreate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	leaseID := d.Get(consts.FieldLeaseID).(string)
+	resp, err := lookupLease(client, leaseID)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return fmt.Errorf("lease %q not found", leaseID)
+	}
+
+	if _, ok := d.GetOk("increment"); ok {
+		if err := renewLease(d, client); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(leaseID)
+
+	return leaseRead(d, meta)
+}
+
+func leaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	if d.HasChange("increment") {
+		if err := renewLease(d, client); err != nil {
+			return err
+		}
+	}
+
+	return leaseRead(d, meta)
+}
+
+func leaseRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	leaseID := d.Id()
+	resp, err := lookupLease(client, leaseID)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		log.Printf("[WARN] Lease %q not found, removing from state", leaseID)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range leaseLookupFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q for lease %q: %w", k, leaseID, err)
+		}
+	}
+
+	return nil
+}
+
+func leaseDelete(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("revoke_on_destroy").(bool) {
+		log.Printf("[DEBUG] Not revoking lease %q, revoke_on_destroy is false", d.Id())
+		return nil
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	leaseID := d.Id()
+	log.Printf("[DEBUG] Revoking lease %q", leaseID)
+	if err := client.Sys().Revoke(leaseID); err != nil {
+		return fmt.Errorf("error revoking lease %q: %w", leaseID, err)
+	}
+	log.Printf("[DEBUG] Revoked lease %q", leaseID)
+
+	return nil
+}
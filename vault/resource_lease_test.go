@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccLease_basic(t *testing.T) {
+	mountPath := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := testutil.GetTestAWSCreds(t)
+	region := testutil.GetTestAWSRegion(t)
+	resourceName := "vault_lease.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccLeaseCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLeaseConfig(mountPath, accessKey, secretKey, region, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, consts.FieldLeaseID,
+						"vault_aws_access_credentials.test", consts.FieldLeaseID),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, consts.FieldLeaseID),
+					resource.TestCheckResourceAttr(resourceName, "renewable", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "issue_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "expire_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "ttl"),
+				),
+			},
+			{
+				Config: testAccLeaseConfig(mountPath, accessKey, secretKey, region, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "increment", "3600"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_renewal"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"increment", "revoke_on_destroy", "ttl"},
+			},
+		},
+	})
+}
+
+func testAccLeaseCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_lease" {
+			continue
+		}
+
+		resp, err := lookupLease(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("lease %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccLeaseConfig(mountPath, accessKey, secretKey, region string, increment int) string {
+	config := testAccAWSAccessCredentialsConfig_basic(mountPath, accessKey, secretKey, region, "2022-06-01")
+	if increment > 0 {
+		return config + fmt.Sprintf(`
+resource "vault_lease" "test" {
+  lease_id  = vault_aws_access_credentials.test.lease_id
+  increment = %d
+}
+`, increment)
+	}
+
+	return config + `
+resource "vault_lease" "test" {
+  lease_id = vault_aws_access_credentials.test.lease_id
+}
+`
+}
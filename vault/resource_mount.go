@@ -7,9 +7,11 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 type schemaMap map[string]*schema.Schema
@@ -69,6 +71,27 @@ func getMountSchema(excludes ...string) schemaMap {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 		},
 
+		"listing_visibility": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Specifies whether to show this mount in the UI-specific listing endpoint. Valid values are \"unauth\" or \"hidden\".",
+			ValidateFunc: validation.StringInSlice([]string{"unauth", "hidden"}, false),
+		},
+
+		"passthrough_request_headers": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of headers to allow and pass from the request to the plugin.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+
+		"allowed_response_headers": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of headers to allow, allowing a plugin to include them in the response.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+
 		"accessor": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -165,6 +188,15 @@ func createMount(d *schema.ResourceData, client *api.Client, path string, mountT
 	if v, ok := d.GetOk("audit_non_hmac_response_keys"); ok {
 		input.Config.AuditNonHMACResponseKeys = expandStringSlice(v.([]interface{}))
 	}
+	if v, ok := d.GetOk("listing_visibility"); ok {
+		input.Config.ListingVisibility = v.(string)
+	}
+	if v, ok := d.GetOk("passthrough_request_headers"); ok {
+		input.Config.PassthroughRequestHeaders = expandStringSlice(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("allowed_response_headers"); ok {
+		input.Config.AllowedResponseHeaders = expandStringSlice(v.([]interface{}))
+	}
 
 	log.Printf("[DEBUG] Creating mount %s in Vault", path)
 
@@ -187,12 +219,26 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 		Options:         mountOptions(d),
 	}
 
+	// an empty slice would be omitted from the request, send a single empty
+	// value instead so that Vault clears the setting.
 	if d.HasChange("audit_non_hmac_request_keys") {
-		config.AuditNonHMACRequestKeys = expandStringSlice(d.Get("audit_non_hmac_request_keys").([]interface{}))
+		config.AuditNonHMACRequestKeys = expandStringSliceWithEmpty(d.Get("audit_non_hmac_request_keys").([]interface{}), true)
 	}
 
 	if d.HasChange("audit_non_hmac_response_keys") {
-		config.AuditNonHMACResponseKeys = expandStringSlice(d.Get("audit_non_hmac_response_keys").([]interface{}))
+		config.AuditNonHMACResponseKeys = expandStringSliceWithEmpty(d.Get("audit_non_hmac_response_keys").([]interface{}), true)
+	}
+
+	if d.HasChange("listing_visibility") {
+		config.ListingVisibility = d.Get("listing_visibility").(string)
+	}
+
+	if d.HasChange("passthrough_request_headers") {
+		config.PassthroughRequestHeaders = expandStringSliceWithEmpty(d.Get("passthrough_request_headers").([]interface{}), true)
+	}
+
+	if d.HasChange("allowed_response_headers") {
+		config.AllowedResponseHeaders = expandStringSliceWithEmpty(d.Get("allowed_response_headers").([]interface{}), true)
 	}
 
 	if d.HasChange("description") {
@@ -205,11 +251,8 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("path") {
 		newPath := d.Get("path").(string)
 
-		log.Printf("[DEBUG] Remount %s to %s in Vault", path, newPath)
-
-		err := client.Sys().Remount(d.Id(), newPath)
-		if err != nil {
-			return fmt.Errorf("error remounting in Vault: %s", err)
+		if err := util.Remount(client, path, newPath, util.RemountTimeout); err != nil {
+			return err
 		}
 
 		d.SetId(newPath)
@@ -232,6 +275,17 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 		break
 	}
 
+	// an empty listing_visibility is omitted from the tune request, send it
+	// on its own so that Vault resets the setting.
+	if d.HasChange("listing_visibility") && config.ListingVisibility == "" {
+		tunePath := "sys/mounts/" + strings.Trim(path, "/") + "/tune"
+		if _, err := client.Logical().Write(tunePath, map[string]interface{}{
+			"listing_visibility": "",
+		}); err != nil {
+			return fmt.Errorf("error resetting listing_visibility on %q: %s", path, err)
+		}
+	}
+
 	return mountRead(d, meta)
 }
 
@@ -306,6 +360,9 @@ func readMount(d *schema.ResourceData, meta interface{}, excludeType bool) error
 	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
 	d.Set("audit_non_hmac_request_keys", mount.Config.AuditNonHMACRequestKeys)
 	d.Set("audit_non_hmac_response_keys", mount.Config.AuditNonHMACResponseKeys)
+	d.Set("listing_visibility", mount.Config.ListingVisibility)
+	d.Set("passthrough_request_headers", mount.Config.PassthroughRequestHeaders)
+	d.Set("allowed_response_headers", mount.Config.AllowedResponseHeaders)
 	d.Set("accessor", mount.Accessor)
 	d.Set("local", mount.Local)
 	d.Set("options", mount.Options)
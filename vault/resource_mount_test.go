@@ -72,6 +72,58 @@ func TestResourceMount(t *testing.T) {
 	})
 }
 
+func TestResourceMount_RemountPreservesData(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	newPath := acctest.RandomWithPrefix("example-remount")
+	resourceName := "vault_mount.test"
+
+	config := func(path string) string {
+		return fmt.Sprintf(`
+resource "vault_mount" "test" {
+	path = "%s"
+	type = "kv"
+}
+`, path)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					func(s *terraform.State) error {
+						client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+						_, err := client.Logical().Write(path+"/foo", map[string]interface{}{
+							"bar": "baz",
+						})
+						return err
+					},
+				),
+			},
+			{
+				Config: config(newPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", newPath),
+					func(s *terraform.State) error {
+						client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+						resp, err := client.Logical().Read(newPath + "/foo")
+						if err != nil {
+							return err
+						}
+						if resp == nil || resp.Data["bar"] != "baz" {
+							return fmt.Errorf("secret was not preserved across the remount, got %#v", resp)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 // Test Local flag
 
 func TestResourceMount_Local(t *testing.T) {
@@ -217,6 +269,98 @@ func TestResourceMount_ExternalEntropyAccess(t *testing.T) {
 	})
 }
 
+func TestResourceMount_SealWrapExternalEntropyAccess(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	resourceName := "vault_mount.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_ConfigSealWrapExternalEntropyAccess(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "seal_wrap", "true"),
+					resource.TestCheckResourceAttr(resourceName, "external_entropy_access", "true"),
+					testResourceMount_CheckExternalEntropyAccess(path, true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceMount_TuneFields(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	resourceName := "vault_mount.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_TuneFieldsConfig(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "listing_visibility", "unauth"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_request_headers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_request_headers.0", "X-Foo"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_request_headers.1", "X-Bar"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_response_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_response_headers.0", "X-Baz"),
+				),
+			},
+			{
+				Config: testResourceMount_TuneFieldsConfig(path, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "listing_visibility", "hidden"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_request_headers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_response_headers.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "test" {
+	path = "%s"
+	type = "kv"
+}
+`, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "listing_visibility", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceMount_TuneFieldsConfig(path string, withHeaders bool) string {
+	if withHeaders {
+		return fmt.Sprintf(`
+resource "vault_mount" "test" {
+	path                        = "%s"
+	type                        = "kv"
+	listing_visibility          = "unauth"
+	passthrough_request_headers = ["X-Foo", "X-Bar"]
+	allowed_response_headers    = ["X-Baz"]
+}
+`, path)
+	}
+
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+	path               = "%s"
+	type               = "kv"
+	listing_visibility = "hidden"
+}
+`, path)
+}
+
 func testResourceMount_initialConfig(cfg mountConfig) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -663,6 +807,18 @@ resource "vault_mount" "test" {
 `, path, externalEntropyAccess)
 }
 
+func testResourceMount_ConfigSealWrapExternalEntropyAccess(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+	path = "%s"
+	type = "transit"
+	description = "Example mount for testing"
+	seal_wrap = true
+	external_entropy_access = true
+}
+`, path)
+}
+
 func findMount(path string) (*api.MountOutput, error) {
 	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
 
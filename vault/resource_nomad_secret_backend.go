@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -36,16 +37,17 @@ func nomadSecretAccessBackendResource() *schema.Resource {
 			Description: `CA certificate to use when verifying Nomad server certificate, must be x509 PEM encoded.`,
 		},
 		"client_cert": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Sensitive:   true,
-			Description: `Client certificate used for Nomad's TLS communication, must be x509 PEM encoded and if this is set you need to also set client_key.`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  `Client certificate used for Nomad's TLS communication, must be x509 PEM encoded and if this is set you need to also set client_key.`,
+			RequiredWith: []string{"client_key"},
 		},
 		"client_key": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Sensitive:   true,
-			Description: `Client key used for Nomad's TLS communication, must be x509 PEM encoded and if this is set you need to also set client_cert.`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			Description:  `Client key used for Nomad's TLS communication, must be x509 PEM encoded and if this is set you need to also set client_cert.`,
+			RequiredWith: []string{"client_cert"},
 		},
 		"default_lease_ttl_seconds": {
 			Type:        schema.TypeInt,
@@ -71,10 +73,11 @@ func nomadSecretAccessBackendResource() *schema.Resource {
 			Description: "Maximum possible lease duration for secrets in seconds.",
 		},
 		"max_token_name_length": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Computed:    true,
-			Description: `Specifies the maximum length to use for the name of the Nomad token generated with Generate Credential. If omitted, 0 is used and ignored, defaulting to the max value allowed by the Nomad version.`,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  `Specifies the maximum length to use for the name of the Nomad token generated with Generate Credential. If omitted, 0 is used and ignored, defaulting to the max value allowed by the Nomad version.`,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		"max_ttl": {
 			Type:        schema.TypeInt,
@@ -311,21 +314,15 @@ func updateNomadAccessConfigResource(d *schema.ResourceData, meta interface{}) e
 		data["address"] = raw
 	}
 
-	if raw, ok := d.GetOk("ca_cert"); ok {
-		data["ca_cert"] = raw
-	}
-
-	if raw, ok := d.GetOk("client_cert"); ok {
-		data["client_cert"] = raw
-	}
-
-	if raw, ok := d.GetOk("client_key"); ok {
-		data["client_key"] = raw
+	// the TLS fields are sent on change so that they can be cleared
+	for _, k := range []string{"ca_cert", "client_cert", "client_key"} {
+		if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
 	}
 
-	if raw, ok := d.GetOk("max_token_name_length"); ok {
-		data["max_token_name_length"] = raw
-	}
+	// Vault resets max_token_name_length to 0 whenever it is omitted
+	data["max_token_name_length"] = d.Get("max_token_name_length")
 
 	if raw, ok := d.GetOk("token"); ok {
 		data["token"] = raw
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -67,6 +68,60 @@ func TestAccNomadSecretBackend(t *testing.T) {
 	})
 }
 
+func TestAccNomadSecretBackend_tls(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-nomad")
+	address, token := testutil.GetTestNomadCreds(t)
+
+	resourceType := "vault_nomad_secret_backend"
+	resourceName := resourceType + ".test"
+	resource.Test(t, resource.TestCase{
+		Providers:                 testProviders,
+		PreCheck:                  func() { testutil.TestAccPreCheck(t) },
+		PreventPostDestroyRefresh: true,
+		CheckDestroy:              testCheckMountDestroyed(resourceType, consts.MountTypeNomad, consts.FieldBackend),
+		Steps: []resource.TestStep{
+			{
+				Config:      testNomadSecretBackendConfig_tls(backend, address, token, "client_cert = local.cert", 0),
+				ExpectError: regexp.MustCompile(`"client_cert": all of ` + "`client_cert,client_key`" + ` must be specified`),
+			},
+			{
+				Config: testNomadSecretBackendConfig_tls(backend, address, token,
+					"ca_cert = local.cert\n  client_cert = local.cert\n  client_key = local.key", 64),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "ca_cert", strings.TrimSpace(testCertificate)),
+					resource.TestCheckResourceAttr(resourceName, "client_cert", strings.TrimSpace(testCertificate)),
+					resource.TestCheckResourceAttr(resourceName, "client_key", strings.TrimSpace(testKey)),
+					resource.TestCheckResourceAttr(resourceName, "max_token_name_length", "64"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "ca_cert", "client_cert", "client_key"},
+			},
+			{
+				// max_token_name_length is kept when only an unrelated field changes.
+				Config: testNomadSecretBackendConfig_tls(backend, address, token,
+					"ca_cert = local.cert\n  client_cert = local.cert\n  client_key = local.key\n  ttl = 60", 64),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, "max_token_name_length", "64"),
+				),
+			},
+			{
+				Config: testNomadSecretBackendConfig_tls(backend, address, token, "", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ca_cert", ""),
+					resource.TestCheckResourceAttr(resourceName, "client_cert", ""),
+					resource.TestCheckResourceAttr(resourceName, "max_token_name_length", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccNomadSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
 
@@ -104,3 +159,26 @@ resource "vault_nomad_secret_backend" "test" {
 }
 `, backend, address, token, maxTTL, ttl, defaultLease, maxLease)
 }
+
+func testNomadSecretBackendConfig_tls(backend, address, token, tlsConfig string, maxTokenNameLength int) string {
+	return fmt.Sprintf(`
+locals {
+  cert = trimspace(<<EOT
+%s
+EOT
+  )
+  key = trimspace(<<EOT
+%s
+EOT
+  )
+}
+
+resource "vault_nomad_secret_backend" "test" {
+  backend               = "%s"
+  address               = "%s"
+  token                 = "%s"
+  max_token_name_length = %d
+  %s
+}
+`, testCertificate, testKey, backend, address, token, maxTokenNameLength, tlsConfig)
+}
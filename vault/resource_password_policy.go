@@ -2,6 +2,8 @@ package vault
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
 
 var passwordPolicyAttributes = []string{"policy"}
@@ -14,7 +16,7 @@ func passwordPolicyResource() *schema.Resource {
 		Read:   resourcePasswordPolicyRead,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: provider.ImportStatePassthroughNamespace,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,7 +65,8 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				Description: "List of alternative URIs.",
 				ForceNew:    true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateURISAN,
 				},
 			},
 			"other_sans": {
@@ -83,10 +84,11 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				ForceNew:    false,
 				Description: "Time to live.",
 			},
+			"not_after": pkiNotAfterSchema(),
 			"format": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "The format of data.",
+				Description:  "The format of the issued certificate data, der data is base64 encoded.",
 				ForceNew:     true,
 				Default:      "pem",
 				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
@@ -94,7 +96,7 @@ func pkiSecretBackendCertResource() *schema.Resource {
 			"private_key_format": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "The private key format.",
+				Description:  "The private key format, der follows format while pkcs8 marshals the key as PKCS#8.",
 				ForceNew:     true,
 				Default:      "der",
 				ValidateFunc: validation.StringInSlice([]string{"der", "pkcs8"}, false),
@@ -209,6 +211,10 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
 	}
 
+	if v, ok := d.GetOk("not_after"); ok {
+		data["not_after"] = v.(string)
+	}
+
 	if len(altNames) > 0 {
 		data["alt_names"] = strings.Join(altNames, ",")
 	}
@@ -340,6 +346,19 @@ func pkiSecretBackendCertDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// pkiNotAfterSchema is shared by the PKI resources that issue or sign certificates.
+func pkiNotAfterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Description: "Set the not after field of the certificate with specified date value. " +
+			"The value format should be given in UTC format YYYY-MM-ddTHH:MM:SSZ.",
+		ValidateFunc:  validation.IsRFC3339Time,
+		ConflictsWith: []string{"ttl"},
+	}
+}
+
 func pkiSecretBackendCertPath(backend string, name string) string {
 	return strings.Trim(backend, "/") + "/issue/" + strings.Trim(name, "/")
 }
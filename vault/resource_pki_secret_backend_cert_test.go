@@ -2,10 +2,12 @@ package vault
 
 import (
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -284,6 +286,260 @@ resource "vault_pki_secret_backend_cert" "test" {
 `, rootPath)
 }
 
+func TestPkiSecretBackendCert_spiffe(t *testing.T) {
+	path := "pki-root-" + strconv.Itoa(acctest.RandInt())
+	spiffeID := "spiffe://test.my.domain/workload/web"
+
+	resourceName := "vault_pki_secret_backend_cert.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config:      testPkiSecretBackendCertConfig_spiffe(path, "spiffe://Test.my.domain/workload/web"),
+				ExpectError: regexp.MustCompile(`expected uri_sans.0 to be a valid SPIFFE ID`),
+			},
+			{
+				Config: testPkiSecretBackendCertConfig_spiffe(path, spiffeID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "uri_sans.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "uri_sans.0", spiffeID),
+					testPKICertSPIFFEID(resourceName, spiffeID),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendCertConfig_spiffe(rootPath, spiffeID string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test root"
+  default_lease_ttl_seconds = "8640000"
+  max_lease_ttl_seconds     = "8640000"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test-root.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.test.backend
+  name             = "spiffe"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+  allowed_uri_sans = ["spiffe://test.my.domain/workload/*"]
+  require_cn       = false
+  server_flag      = true
+  client_flag      = true
+  key_usage        = ["DigitalSignature", "KeyEncipherment", "KeyAgreement"]
+  max_ttl          = "3600"
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend              = vault_pki_secret_backend_role.test.backend
+  name                 = vault_pki_secret_backend_role.test.name
+  common_name          = "web.test.my.domain"
+  exclude_cn_from_sans = true
+  uri_sans             = ["%s"]
+  ttl                  = "1h"
+}
+`, rootPath, spiffeID)
+}
+
+func TestPkiSecretBackendCert_format(t *testing.T) {
+	path := "pki-root-" + strconv.Itoa(acctest.RandInt())
+
+	resourceName := "vault_pki_secret_backend_cert.test"
+
+	var steps []resource.TestStep
+	for _, f := range []struct {
+		format           string
+		privateKeyFormat string
+	}{
+		{"pem", "der"},
+		{"pem", "pkcs8"},
+		{"der", "der"},
+		{"der", "pkcs8"},
+		{"pem_bundle", "pkcs8"},
+	} {
+		steps = append(steps, resource.TestStep{
+			Config: testPkiSecretBackendCertConfig_format(path, f.format, f.privateKeyFormat),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceName, "format", f.format),
+				resource.TestCheckResourceAttr(resourceName, "private_key_format", f.privateKeyFormat),
+				resource.TestCheckResourceAttr(resourceName, "private_key_type", "rsa"),
+				testPKICertFormat(resourceName, f.format, f.privateKeyFormat),
+			),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: append([]resource.TestStep{
+			{
+				Config:      testPkiSecretBackendCertConfig_format(path, "pem", "pem"),
+				ExpectError: regexp.MustCompile(`expected private_key_format to be one of \[der pkcs8\]`),
+			},
+		}, steps...),
+	})
+}
+
+func testPkiSecretBackendCertConfig_format(rootPath, format, privateKeyFormat string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test root"
+  default_lease_ttl_seconds = "8640000"
+  max_lease_ttl_seconds     = "8640000"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test-root.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.test.backend
+  name             = "format"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+  key_type         = "rsa"
+  key_bits         = 2048
+  max_ttl          = "3600"
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend            = vault_pki_secret_backend_role.test.backend
+  name               = vault_pki_secret_backend_role.test.name
+  common_name        = "cert.test.my.domain"
+  ttl                = "1h"
+  format             = "%s"
+  private_key_format = "%s"
+}
+`, rootPath, format, privateKeyFormat)
+}
+
+// testPKICertFormat checks that the issued certificate and private key are
+// encoded according to format and privateKeyFormat.
+func testPKICertFormat(resourceName, format, privateKeyFormat string) resource.TestCheckFunc {
+	// decode returns the first PEM block of the given type, a pem_bundle
+	// contains the private key ahead of the certificate.
+	decode := func(v, blockType string) ([]byte, error) {
+		if format == "der" {
+			return base64.StdEncoding.DecodeString(v)
+		}
+
+		rest := []byte(v)
+		for {
+			var b *pem.Block
+			b, rest = pem.Decode(rest)
+			if b == nil {
+				return nil, fmt.Errorf("no %s PEM block found", blockType)
+			}
+			if strings.HasSuffix(b.Type, blockType) {
+				return b.Bytes, nil
+			}
+		}
+	}
+
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		certBytes, err := decode(rs.Primary.Attributes["certificate"], "CERTIFICATE")
+		if err != nil {
+			return fmt.Errorf("invalid certificate: %w", err)
+		}
+		if _, err := x509.ParseCertificate(certBytes); err != nil {
+			return fmt.Errorf("invalid certificate: %w", err)
+		}
+
+		keyBytes, err := decode(rs.Primary.Attributes["private_key"], "PRIVATE KEY")
+		if err != nil {
+			return fmt.Errorf("invalid private_key: %w", err)
+		}
+		if privateKeyFormat == "pkcs8" {
+			_, err = x509.ParsePKCS8PrivateKey(keyBytes)
+		} else {
+			_, err = x509.ParsePKCS1PrivateKey(keyBytes)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s private_key: %w", privateKeyFormat, err)
+		}
+
+		if format == "pem_bundle" {
+			bundle := rs.Primary.Attributes["certificate"]
+			if !strings.Contains(bundle, "PRIVATE KEY-----") {
+				return fmt.Errorf("expected the certificate bundle to contain the private key")
+			}
+		}
+
+		return nil
+	}
+}
+
+// testPKICertSPIFFEID checks that the issued certificate is a valid X.509 SVID
+// for the given SPIFFE ID.
+func testPKICertSPIFFEID(resourceName, spiffeID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		b, _ := pem.Decode([]byte(rs.Primary.Attributes["certificate"]))
+		if b == nil {
+			return fmt.Errorf("failed to decode the certificate PEM")
+		}
+
+		cert, err := x509.ParseCertificate(b.Bytes)
+		if err != nil {
+			return err
+		}
+
+		if len(cert.URIs) != 1 || cert.URIs[0].String() != spiffeID {
+			return fmt.Errorf("expected a single URI SAN %q, got %v", spiffeID, cert.URIs)
+		}
+
+		if cert.IsCA {
+			return fmt.Errorf("expected a leaf certificate")
+		}
+
+		if cert.KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+			return fmt.Errorf("expected the DigitalSignature key usage, got %v", cert.KeyUsage)
+		}
+
+		extKeyUsage := map[x509.ExtKeyUsage]bool{}
+		for _, u := range cert.ExtKeyUsage {
+			extKeyUsage[u] = true
+		}
+		if !extKeyUsage[x509.ExtKeyUsageServerAuth] || !extKeyUsage[x509.ExtKeyUsageClientAuth] {
+			return fmt.Errorf("expected the ServerAuth and ClientAuth extended key usages, got %v", cert.ExtKeyUsage)
+		}
+
+		if len(cert.PolicyIdentifiers) > 0 {
+			return fmt.Errorf("expected no policy identifiers, got %v", cert.PolicyIdentifiers)
+		}
+
+		return nil
+	}
+}
+
 func testCapturePKICert(resourceName string, store *testPKICertStore) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
@@ -0,0 +1,243 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+// pkiSecretBackendTidyFields are the fields accepted by both the auto-tidy
+// config and the manual tidy endpoints.
+var pkiSecretBackendTidyFields = []string{
+	"tidy_cert_store",
+	"tidy_revoked_certs",
+	"tidy_revoked_cert_issuer_associations",
+	"tidy_expired_issuers",
+	"tidy_move_legacy_ca_bundle",
+	"safety_buffer",
+	"issuer_safety_buffer",
+	"pause_duration",
+}
+
+var pkiSecretBackendConfigAutoTidyFields = append([]string{
+	"enabled",
+	"interval_duration",
+}, pkiSecretBackendTidyFields...)
+
+func pkiSecretBackendConfigAutoTidyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigAutoTidyCreateUpdate,
+		Read:   pkiSecretBackendConfigAutoTidyRead,
+		Update: pkiSecretBackendConfigAutoTidyCreateUpdate,
+		Delete: pkiSecretBackendConfigAutoTidyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				id := d.Id()
+				if id == "" {
+					return nil, fmt.Errorf("no path set for import, id=%q", id)
+				}
+
+				parts := strings.Split(util.NormalizeMountPath(id), "/config/auto-tidy")
+				if err := d.Set("backend", parts[0]); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies whether automatic tidy is enabled.",
+			},
+			"interval_duration": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Interval in seconds at which to run an automatic tidy operation.",
+			},
+			"tidy_cert_store": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to tidy up the certificate store.",
+			},
+			"tidy_revoked_certs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to remove all revoked and expired certificates from storage.",
+			},
+			"tidy_revoked_cert_issuer_associations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to associate revoked certificates with their corresponding issuers.",
+			},
+			"tidy_expired_issuers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to automatically remove expired issuers once past the issuer_safety_buffer.",
+			},
+			"tidy_move_legacy_ca_bundle": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to move the legacy CA bundle to a backup location once migrated to the issuers storage.",
+			},
+			"safety_buffer": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The amount of extra time in seconds that must have passed beyond certificate expiration before it is removed from the backend storage.",
+			},
+			"issuer_safety_buffer": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The amount of extra time in seconds that must have passed beyond issuer expiration before it is removed from the backend storage.",
+			},
+			"pause_duration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The amount of time to wait between processing certificates, e.g. 100ms.",
+			},
+			"tidy_on_apply": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Start a manual tidy operation with the configured tidy settings " +
+					"whenever the resource is created or updated.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigAutoTidyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigAutoTidyPath(backend)
+
+	action := "Create"
+	if !d.IsNewResource() {
+		action = "Update"
+	}
+
+	data := map[string]interface{}{}
+	for _, k := range pkiSecretBackendConfigAutoTidyFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] %s auto-tidy config on PKI secret backend %q", action, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing PKI auto-tidy config to %q: %w", backend, err)
+	}
+	log.Printf("[DEBUG] %sd auto-tidy config on PKI secret backend %q", action, backend)
+
+	if d.IsNewResource() {
+		d.SetId(path)
+	}
+
+	if d.Get("tidy_on_apply").(bool) {
+		tidyData := map[string]interface{}{}
+		for _, k := range pkiSecretBackendTidyFields {
+			if v, ok := d.GetOkExists(k); ok {
+				tidyData[k] = v
+			}
+		}
+
+		tidyPath := strings.Trim(backend, "/") + "/tidy"
+		log.Printf("[DEBUG] Starting tidy operation on PKI secret backend %q", backend)
+		if _, err := client.Logical().Write(tidyPath, tidyData); err != nil {
+			return fmt.Errorf("error starting tidy operation on PKI secret backend %q: %w", backend, err)
+		}
+		log.Printf("[DEBUG] Started tidy operation on PKI secret backend %q", backend)
+	}
+
+	return pkiSecretBackendConfigAutoTidyRead(d, meta)
+}
+
+func pkiSecretBackendConfigAutoTidyRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+
+	if path == "" {
+		return fmt.Errorf("no path set, id=%q", d.Id())
+	}
+
+	log.Printf("[DEBUG] Reading auto-tidy config from PKI secret path %q", path)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading auto-tidy config on PKI secret backend %q: %s", path, err)
+	}
+
+	if config == nil {
+		log.Printf("[WARN] Removing auto-tidy config path %q as its ID is invalid", path)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range pkiSecretBackendConfigAutoTidyFields {
+		if v, ok := config.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigAutoTidyDelete(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling auto-tidy on PKI secret path %q", path)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"enabled": false,
+	})
+	if err != nil {
+		if util.Is404(err) {
+			return nil
+		}
+		return fmt.Errorf("error disabling auto-tidy on PKI secret path %q: %w", path, err)
+	}
+	log.Printf("[DEBUG] Disabled auto-tidy on PKI secret path %q", path)
+
+	return nil
+}
+
+func pkiSecretBackendConfigAutoTidyPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/auto-tidy"
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendConfigAutoTidy_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki-root")
+	resourceName := "vault_pki_secret_backend_config_auto_tidy.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigAutoTidyConfig(backend, `
+  enabled            = true
+  interval_duration  = 3600
+  tidy_cert_store    = true
+  tidy_revoked_certs = true
+  safety_buffer      = 86400
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/config/auto-tidy"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "interval_duration", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "tidy_cert_store", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tidy_revoked_certs", "true"),
+					resource.TestCheckResourceAttr(resourceName, "safety_buffer", "86400"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer_safety_buffer"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigAutoTidyConfig(backend, `
+  enabled              = false
+  tidy_cert_store      = false
+  tidy_expired_issuers = true
+  issuer_safety_buffer = 172800
+  tidy_on_apply        = true
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tidy_cert_store", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tidy_expired_issuers", "true"),
+					resource.TestCheckResourceAttr(resourceName, "issuer_safety_buffer", "172800"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tidy_on_apply"},
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigAutoTidyConfig(backend, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds     = 86400
+}
+
+resource "vault_pki_secret_backend_config_auto_tidy" "test" {
+  backend = vault_mount.test.path
+  %s
+}
+`, backend, extra)
+}
@@ -0,0 +1,230 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var pkiSecretBackendConfigESTFields = []string{
+	"enabled",
+	"default_mount",
+	"default_path_policy",
+	"label_to_path_policy",
+	"enable_sentinel_parsing",
+}
+
+func pkiSecretBackendConfigESTResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigESTCreateUpdate,
+		Read:   pkiSecretBackendConfigESTRead,
+		Update: pkiSecretBackendConfigESTCreateUpdate,
+		Delete: pkiSecretBackendConfigESTDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				id := d.Id()
+				if id == "" {
+					return nil, fmt.Errorf("no path set for import, id=%q", id)
+				}
+
+				parts := strings.Split(util.NormalizeMountPath(id), "/config/est")
+				if err := d.Set("backend", parts[0]); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies whether EST is enabled.",
+			},
+			"default_mount": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether this mount is the default EST mount, serving the /.well-known/est endpoints.",
+			},
+			"default_path_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Policy used for the default EST path. Must be sign-verbatim or role:<role_name>.",
+			},
+			"label_to_path_policy": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Mapping of EST labels to a path policy, either sign-verbatim or role:<role_name>.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"authenticators": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Auth mounts that may be used to authenticate EST requests.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cert": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "The accessor and cert_role of a TLS certificate auth mount.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"userpass": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "The accessor of a userpass auth mount.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"enable_sentinel_parsing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to parse the CSR fields so that they are available to Sentinel policies.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigESTCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigESTPath(backend)
+
+	action := "Create"
+	if !d.IsNewResource() {
+		action = "Update"
+	}
+
+	data := map[string]interface{}{}
+	for _, k := range pkiSecretBackendConfigESTFields {
+		data[k] = d.Get(k)
+	}
+
+	authenticators := map[string]interface{}{}
+	if v, ok := d.GetOk("authenticators"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			for _, k := range []string{"cert", "userpass"} {
+				if a, ok := m[k]; ok && len(a.(map[string]interface{})) > 0 {
+					authenticators[k] = a
+				}
+			}
+		}
+	}
+	data["authenticators"] = authenticators
+
+	log.Printf("[DEBUG] %s EST config on PKI secret backend %q", action, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing PKI EST config to %q: %w", backend, err)
+	}
+	log.Printf("[DEBUG] %sd EST config on PKI secret backend %q", action, backend)
+
+	if d.IsNewResource() {
+		d.SetId(path)
+	}
+
+	return pkiSecretBackendConfigESTRead(d, meta)
+}
+
+func pkiSecretBackendConfigESTRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+
+	if path == "" {
+		return fmt.Errorf("no path set, id=%q", d.Id())
+	}
+
+	log.Printf("[DEBUG] Reading EST config from PKI secret path %q", path)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading EST config on PKI secret backend %q: %s", path, err)
+	}
+
+	if config == nil {
+		log.Printf("[WARN] Removing EST config path %q as its ID is invalid", path)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range pkiSecretBackendConfigESTFields {
+		if err := d.Set(k, config.Data[k]); err != nil {
+			return err
+		}
+	}
+
+	var authenticators []interface{}
+	if v, ok := config.Data["authenticators"].(map[string]interface{}); ok && len(v) > 0 {
+		m := map[string]interface{}{}
+		for _, k := range []string{"cert", "userpass"} {
+			if a, ok := v[k]; ok {
+				m[k] = a
+			}
+		}
+		authenticators = append(authenticators, m)
+	}
+	if err := d.Set("authenticators", authenticators); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// pkiSecretBackendConfigESTDelete disables EST on the backend and resets its
+// config to the defaults, since the config itself can not be deleted.
+func pkiSecretBackendConfigESTDelete(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+	data := map[string]interface{}{
+		"enabled":                 false,
+		"default_mount":           false,
+		"default_path_policy":     "",
+		"label_to_path_policy":    map[string]interface{}{},
+		"authenticators":          map[string]interface{}{},
+		"enable_sentinel_parsing": false,
+	}
+
+	log.Printf("[DEBUG] Disabling EST config on PKI secret path %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		if util.Is404(err) {
+			return nil
+		}
+		return fmt.Errorf("error disabling EST config on PKI secret path %q: %w", path, err)
+	}
+	log.Printf("[DEBUG] Disabled EST config on PKI secret path %q", path)
+
+	return nil
+}
+
+func pkiSecretBackendConfigESTPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/est"
+}
@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestPkiSecretBackendConfigEST_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki-root")
+	resourceName := "vault_pki_secret_backend_config_est.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestEntPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testPkiSecretBackendConfigESTCheckDestroy,
+			testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigESTConfig(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_mount", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_path_policy", "sign-verbatim"),
+					resource.TestCheckResourceAttr(resourceName, "label_to_path_policy.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "label_to_path_policy.test-label", "role:est-role"),
+					resource.TestCheckResourceAttr(resourceName, "authenticators.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "authenticators.0.userpass.accessor",
+						"vault_auth_backend.test", "accessor"),
+					resource.TestCheckResourceAttr(resourceName, "enable_sentinel_parsing", "false"),
+				),
+			},
+			{
+				Config:            testPkiSecretBackendConfigESTConfig(backend, true),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testPkiSecretBackendConfigESTConfig(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_path_policy", "role:est-role"),
+					resource.TestCheckResourceAttr(resourceName, "label_to_path_policy.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "enable_sentinel_parsing", "true"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigESTConfig(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				// removing the resource disables EST on the backend.
+				Config: testPkiSecretBackendConfigESTBaseConfig(backend),
+				Check:  testPkiSecretBackendConfigESTCheckDisabled(pkiSecretBackendConfigESTPath(backend)),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigESTConfig(backend string, updated bool) string {
+	est := `
+resource "vault_pki_secret_backend_config_est" "test" {
+  backend             = vault_pki_secret_backend_role.test.backend
+  enabled             = true
+  default_path_policy = "sign-verbatim"
+  label_to_path_policy = {
+    "test-label" = "role:est-role"
+  }
+  authenticators {
+    userpass = {
+      accessor = vault_auth_backend.test.accessor
+    }
+  }
+}
+`
+	if updated {
+		est = `
+resource "vault_pki_secret_backend_config_est" "test" {
+  backend                 = vault_pki_secret_backend_role.test.backend
+  enabled                 = false
+  default_path_policy     = "role:est-role"
+  enable_sentinel_parsing = true
+  authenticators {
+    userpass = {
+      accessor = vault_auth_backend.test.accessor
+    }
+  }
+}
+`
+	}
+
+	return testPkiSecretBackendConfigESTBaseConfig(backend) + est
+}
+
+func testPkiSecretBackendConfigESTBaseConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds     = 86400
+}
+
+resource "vault_auth_backend" "test" {
+  type = "userpass"
+  path = "%s-userpass"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend = vault_mount.test.path
+  name    = "est-role"
+}
+`, backend, backend)
+}
+
+func testPkiSecretBackendConfigESTCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_pki_secret_backend_config_est" {
+			continue
+		}
+		if err := testPkiSecretBackendConfigESTCheckDisabled(rs.Primary.ID)(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testPkiSecretBackendConfigESTCheckDisabled(path string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			// the backend has been unmounted along with its EST config.
+			if util.Is404(err) {
+				return nil
+			}
+			return err
+		}
+		if resp == nil {
+			return nil
+		}
+
+		if enabled, _ := resp.Data["enabled"].(bool); enabled {
+			return fmt.Errorf("EST is still enabled at %q", path)
+		}
+
+		return nil
+	}
+}
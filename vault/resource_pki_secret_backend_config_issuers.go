@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+var pkiSecretBackendConfigIssuersFields = []string{
+	"default",
+	"default_follows_latest_issuer",
+}
+
+func pkiSecretBackendConfigIssuersResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigIssuersCreateUpdate,
+		Read:   pkiSecretBackendConfigIssuersRead,
+		Update: pkiSecretBackendConfigIssuersCreateUpdate,
+		Delete: pkiSecretBackendConfigIssuersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				id := d.Id()
+				if id == "" {
+					return nil, fmt.Errorf("no path set for import, id=%q", id)
+				}
+
+				parts := strings.Split(util.NormalizeMountPath(id), "/config/issuers")
+				if err := d.Set("backend", parts[0]); err != nil {
+					return nil, err
+				}
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+			},
+			"default": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the default issuer by ID or name.",
+			},
+			"default_follows_latest_issuer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies whether a root creation or an issuer import operation updates the default issuer to the newly added issuer.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigIssuersCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+
+	path := pkiSecretBackendConfigIssuersPath(backend)
+
+	action := "Create"
+	if !d.IsNewResource() {
+		action = "Update"
+	}
+
+	data := map[string]interface{}{}
+	for _, k := range pkiSecretBackendConfigIssuersFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] %s issuers config on PKI secret backend %q", action, backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing PKI issuers config to %q: %w", backend, err)
+	}
+	log.Printf("[DEBUG] %sd issuers config on PKI secret backend %q", action, backend)
+
+	if d.IsNewResource() {
+		d.SetId(path)
+	}
+
+	return pkiSecretBackendConfigIssuersRead(d, meta)
+}
+
+func pkiSecretBackendConfigIssuersRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+
+	if path == "" {
+		return fmt.Errorf("no path set, id=%q", d.Id())
+	}
+
+	log.Printf("[DEBUG] Reading issuers config from PKI secret path %q", path)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuers config on PKI secret backend %q: %s", path, err)
+	}
+
+	if config == nil {
+		log.Printf("[WARN] Removing issuers config path %q as its ID is invalid", path)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range pkiSecretBackendConfigIssuersFields {
+		if k == "default" {
+			v, err := pkiSecretBackendConfigIssuersDefault(d, client, config.Data[k])
+			if err != nil {
+				return err
+			}
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := d.Set(k, config.Data[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pkiSecretBackendConfigIssuersDefault returns the default issuer as it was
+// configured. Vault always returns the issuer ID, so the configured name is
+// kept as long as it still refers to the same issuer.
+func pkiSecretBackendConfigIssuersDefault(d *schema.ResourceData, client *api.Client, issuerID interface{}) (interface{}, error) {
+	ref := d.Get("default").(string)
+	if ref == "" || ref == issuerID {
+		return issuerID, nil
+	}
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/issuer/" + ref
+	log.Printf("[DEBUG] Reading PKI issuer from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading PKI issuer from %q: %w", path, err)
+	}
+	if resp != nil && resp.Data["issuer_id"] == issuerID {
+		return ref, nil
+	}
+
+	return issuerID, nil
+}
+
+func pkiSecretBackendConfigIssuersDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func pkiSecretBackendConfigIssuersPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/issuers"
+}
@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendConfigIssuers_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki-root")
+	resourceName := "vault_pki_secret_backend_config_issuers.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigIssuersConfig(backend, "default_follows_latest_issuer = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_follows_latest_issuer", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "default"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigIssuersConfig(backend, "default_follows_latest_issuer = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_follows_latest_issuer", "true"),
+					testPkiSecretBackendConfigIssuersCheckDefault(resourceName, backend),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+					if _, err := client.Logical().Write(backend+"/issuer/default", map[string]interface{}{
+						"issuer_name": "test-root",
+					}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testPkiSecretBackendConfigIssuersConfig(backend, `default = "test-root"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default", "test-root"),
+				),
+			},
+			{
+				// Vault returns the issuer ID rather than its name.
+				Config:   testPkiSecretBackendConfigIssuersConfig(backend, `default = "test-root"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigIssuersCheckDefault(resourceName, backend string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+		resp, err := client.Logical().Read(backend + "/issuer/default")
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("no default issuer found on %q", backend)
+		}
+
+		if actual, expected := rs.Primary.Attributes["default"], resp.Data["issuer_id"]; actual != expected {
+			return fmt.Errorf("expected default issuer %q, got %q", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testPkiSecretBackendConfigIssuersConfig(backend, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds     = 86400
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_config_issuers" "test" {
+  backend = vault_pki_secret_backend_root_cert.test.backend
+  %s
+}
+`, backend, extra)
+}
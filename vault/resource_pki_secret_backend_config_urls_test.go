@@ -78,6 +78,51 @@ func TestPkiSecretBackendConfigUrls_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendConfigUrls_templating(t *testing.T) {
+	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+
+	issuingCertificates := "http://127.0.0.1:8200/v1/" + rootPath + "/issuer/{{issuer_id}}/der"
+	crlDistributionPoints := "http://127.0.0.1:8200/v1/" + rootPath + "/issuer/{{issuer_id}}/crl/der"
+
+	resourceName := "vault_pki_secret_backend_config_urls.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCertConfigUrlsConfig_templating(
+					rootPath, issuingCertificates, crlDistributionPoints, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enable_templating", "true"),
+					resource.TestCheckResourceAttr(resourceName, "issuing_certificates.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "issuing_certificates.0", issuingCertificates),
+					resource.TestCheckResourceAttr(resourceName, "crl_distribution_points.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "crl_distribution_points.0", crlDistributionPoints),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testPkiSecretBackendCertConfigUrlsConfig_templating(
+					rootPath, "http://127.0.0.1:8200/v1/pki/ca", "http://127.0.0.1:8200/v1/pki/crl", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enable_templating", "false"),
+					resource.TestCheckResourceAttr(resourceName, "issuing_certificates.0", "http://127.0.0.1:8200/v1/pki/ca"),
+					resource.TestCheckResourceAttr(resourceName, "crl_distribution_points.0", "http://127.0.0.1:8200/v1/pki/crl"),
+				),
+			},
+		},
+	})
+}
+
 func testPkiSecretBackendConfigUrlsEmptyRead(s *terraform.State) error {
 	paths, err := listPkiPaths(s)
 	if err != nil {
@@ -145,3 +190,18 @@ resource "vault_pki_secret_backend_config_urls" "test" {
 		testPkiSecretBackendCertConfigUrlsMountConfig(rootPath),
 		issuingCertificates, crlDistributionPoints, ocspServers)
 }
+
+func testPkiSecretBackendCertConfigUrlsConfig_templating(rootPath, issuingCertificates, crlDistributionPoints string, enableTemplating bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_pki_secret_backend_config_urls" "test" {
+  backend                 = vault_mount.test-root.path
+  issuing_certificates    = ["%s"]
+  crl_distribution_points = ["%s"]
+  enable_templating       = %t
+}
+`,
+		testPkiSecretBackendCertConfigUrlsMountConfig(rootPath),
+		issuingCertificates, crlDistributionPoints, enableTemplating)
+}
@@ -61,7 +61,8 @@ func pkiSecretBackendIntermediateCertRequestResource() *schema.Resource {
 				Description: "List of alternative URIs.",
 				ForceNew:    true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateURISAN,
 				},
 			},
 			"other_sans": {
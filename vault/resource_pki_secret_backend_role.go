@@ -16,6 +16,19 @@ import (
 var (
 	pkiSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 	pkiSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+)$")
+
+	// pkiSecretBackendRoleV111Fields are only supported by newer Vault versions,
+	// so they are only sent when configured or changed, and only read when returned.
+	pkiSecretBackendRoleV111Fields = []string{
+		"allow_wildcard_certificates",
+		"allowed_uri_sans_template",
+		"issuer_ref",
+		"allowed_user_ids",
+		"cn_validations",
+		"signature_bits",
+		"use_pss",
+		"no_store_metadata",
+	}
 )
 
 func pkiSecretBackendRoleResource() *schema.Resource {
@@ -98,6 +111,12 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Description: "Flag to allow names containing glob patterns.",
 				Default:     false,
 			},
+			"allow_wildcard_certificates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Flag to allow wildcard certificates.",
+				Default:     true,
+			},
 			"allow_any_name": {
 				Type:        schema.TypeBool,
 				Required:    false,
@@ -125,9 +144,16 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Optional:    true,
 				Description: "Defines allowed URI SANs",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
+			"allowed_uri_sans_template": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Flag to indicate that `allowed_uri_sans` specifies a template expression (e.g. {{identity.entity.aliases.<mount accessor>.name}})",
+				Default:     false,
+			},
 			"allowed_other_sans": {
 				Type:        schema.TypeList,
 				Required:    false,
@@ -180,6 +206,19 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Description: "The number of bits of generated keys.",
 				Default:     2048,
 			},
+			"signature_bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The number of bits to use in the signature algorithm.",
+				ValidateFunc: validation.IntInSlice([]int{0, 256, 384, 512}),
+			},
+			"use_pss": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specifies whether or not to use PSS signatures over PKCS#1v1.5 signatures when a RSA-type issuer is used.",
+			},
 			"key_usage": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -198,6 +237,15 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"ext_key_usage_oids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specify the allowed extended key usage OIDs constraint on issued certificates.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateOID,
+				},
+			},
 			"use_csr_common_name": {
 				Type:        schema.TypeBool,
 				Required:    false,
@@ -275,6 +323,12 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Description: "Flag to not store certificates in the storage backend.",
 				Default:     false,
 			},
+			"no_store_metadata": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Flag to not store certificate metadata in the storage backend.",
+				Default:     false,
+			},
 			"require_cn": {
 				Type:        schema.TypeBool,
 				Required:    false,
@@ -283,12 +337,40 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Default:     true,
 			},
 			"policy_identifiers": {
-				Type:        schema.TypeList,
-				Required:    false,
-				Optional:    true,
-				Description: "Specify the list of allowed policies IODs.",
+				Type:          schema.TypeList,
+				Required:      false,
+				Optional:      true,
+				Description:   "Specify the list of allowed policies OIDs.",
+				ConflictsWith: []string{"policy_identifier"},
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateOID,
+				},
+			},
+			"policy_identifier": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Description:   "Policy identifiers with an optional CPS URI and user notice, requires Vault 1.11+.",
+				ConflictsWith: []string{"policy_identifiers"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oid": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The OID of the policy identifier.",
+							ValidateFunc: validateOID,
+						},
+						"cps": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The URL of the CPS for the policy identifier.",
+						},
+						"notice": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A notice for the policy identifier.",
+						},
+					},
 				},
 			},
 			"basic_constraints_valid_for_non_ca": {
@@ -311,10 +393,35 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Required:    false,
 				Optional:    true,
 				Description: "Defines allowed Subject serial numbers.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the default issuer of this request.",
+			},
+			"allowed_user_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The allowed User ID's.",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"cn_validations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specify validations to run on the Common Name field of the certificate.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"email", "hostname", "disabled"}, false),
+				},
+			},
 		},
 	}
 }
@@ -354,10 +461,9 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
-	iPolicyIdentifiers := d.Get("policy_identifiers").([]interface{})
-	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
-	for _, iIdentifier := range iPolicyIdentifiers {
-		policyIdentifiers = append(policyIdentifiers, iIdentifier.(string))
+	policyIdentifiers, err := pkiSecretBackendRolePolicyIdentifiers(d)
+	if err != nil {
+		return err
 	}
 
 	iAllowedSerialNumbers := d.Get("allowed_serial_numbers").([]interface{})
@@ -399,6 +505,7 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		"require_cn":                         d.Get("require_cn"),
 		"basic_constraints_valid_for_non_ca": d.Get("basic_constraints_valid_for_non_ca"),
 		"not_before_duration":                d.Get("not_before_duration"),
+		"ext_key_usage_oids":                 d.Get("ext_key_usage_oids"),
 	}
 
 	if len(allowedDomains) > 0 {
@@ -413,7 +520,7 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		data["ext_key_usage"] = extKeyUsage
 	}
 
-	if len(policyIdentifiers) > 0 {
+	if policyIdentifiers != nil {
 		data["policy_identifiers"] = policyIdentifiers
 	}
 
@@ -421,8 +528,10 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		data["allowed_serial_numbers"] = allowedSerialNumbers
 	}
 
+	setPKIRoleV111Fields(d, data)
+
 	log.Printf("[DEBUG] Creating role %s on PKI secret backend %q", name, backend)
-	_, err := client.Logical().Write(path, data)
+	_, err = client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error creating role %s for backend %q: %s", name, backend, err)
 	}
@@ -488,10 +597,9 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
-	iPolicyIdentifiers := secret.Data["policy_identifiers"].([]interface{})
-	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
-	for _, iIdentifier := range iPolicyIdentifiers {
-		policyIdentifiers = append(policyIdentifiers, iIdentifier.(string))
+	policyIdentifiers, policyIdentifierBlocks, err := readPKIPolicyIdentifiers(secret.Data["policy_identifiers"])
+	if err != nil {
+		return fmt.Errorf("error reading policy identifiers of role %q: %s", path, err)
 	}
 
 	notBeforeDuration := flattenVaultDuration(secret.Data["not_before_duration"])
@@ -538,10 +646,20 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("no_store", secret.Data["no_store"])
 	d.Set("require_cn", secret.Data["require_cn"])
 	d.Set("policy_identifiers", policyIdentifiers)
+	d.Set("policy_identifier", policyIdentifierBlocks)
+	d.Set("ext_key_usage_oids", secret.Data["ext_key_usage_oids"])
 	d.Set("basic_constraints_valid_for_non_ca", secret.Data["basic_constraints_valid_for_non_ca"])
 	d.Set("not_before_duration", notBeforeDuration)
 	d.Set("allowed_serial_numbers", allowedSerialNumbers)
 
+	for _, k := range pkiSecretBackendRoleV111Fields {
+		if v, ok := secret.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q for role %q: %s", k, path, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -572,10 +690,9 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
-	iPolicyIdentifiers := d.Get("policy_identifiers").([]interface{})
-	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
-	for _, iIdentifier := range iPolicyIdentifiers {
-		policyIdentifiers = append(policyIdentifiers, iIdentifier.(string))
+	policyIdentifiers, err := pkiSecretBackendRolePolicyIdentifiers(d)
+	if err != nil {
+		return err
 	}
 
 	iAllowedSerialNumbers := d.Get("allowed_serial_numbers").([]interface{})
@@ -617,6 +734,7 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		"require_cn":                         d.Get("require_cn"),
 		"basic_constraints_valid_for_non_ca": d.Get("basic_constraints_valid_for_non_ca"),
 		"not_before_duration":                d.Get("not_before_duration"),
+		"ext_key_usage_oids":                 d.Get("ext_key_usage_oids"),
 	}
 
 	if len(allowedDomains) > 0 {
@@ -631,7 +749,7 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		data["ext_key_usage"] = extKeyUsage
 	}
 
-	if len(policyIdentifiers) > 0 {
+	if policyIdentifiers != nil {
 		data["policy_identifiers"] = policyIdentifiers
 	}
 
@@ -639,7 +757,9 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		data["allowed_serial_numbers"] = allowedSerialNumbers
 	}
 
-	_, err := client.Logical().Write(path, data)
+	setPKIRoleV111Fields(d, data)
+
+	_, err = client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error updating PKI secret backend role %q: %s", path, err)
 	}
@@ -680,6 +800,85 @@ func pkiSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool,
 	return secret != nil, nil
 }
 
+// setPKIRoleV111Fields sends the configured fields on every write, since
+// Vault resets any field that is omitted from a role write to its default.
+func setPKIRoleV111Fields(d *schema.ResourceData, data map[string]interface{}) {
+	config := d.GetRawConfig()
+	for _, k := range pkiSecretBackendRoleV111Fields {
+		if !config.GetAttr(k).IsNull() || d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+}
+
+// pkiSecretBackendRolePolicyIdentifiers returns the policy identifiers to send to Vault,
+// policy_identifier blocks are sent as a JSON encoded list of objects,
+// while policy_identifiers are sent as a list of OIDs.
+func pkiSecretBackendRolePolicyIdentifiers(d *schema.ResourceData) (interface{}, error) {
+	if v, ok := d.GetOk("policy_identifier"); ok {
+		var identifiers []map[string]interface{}
+		for _, i := range v.(*schema.Set).List() {
+			identifiers = append(identifiers, i.(map[string]interface{}))
+		}
+
+		b, err := json.Marshal(identifiers)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding policy identifiers: %s", err)
+		}
+
+		return string(b), nil
+	}
+
+	var identifiers []string
+	for _, i := range d.Get("policy_identifiers").([]interface{}) {
+		identifiers = append(identifiers, i.(string))
+	}
+	if len(identifiers) == 0 {
+		return nil, nil
+	}
+
+	return identifiers, nil
+}
+
+// readPKIPolicyIdentifiers splits the policy identifiers returned by Vault into plain OIDs,
+// and policy identifiers with a CPS or notice. Vault returns the latter as JSON encoded objects.
+func readPKIPolicyIdentifiers(raw interface{}) ([]string, []map[string]interface{}, error) {
+	l, ok := raw.([]interface{})
+	if !ok {
+		return nil, nil, nil
+	}
+
+	var oids []string
+	var blocks []map[string]interface{}
+	for _, v := range l {
+		var m map[string]interface{}
+		switch i := v.(type) {
+		case map[string]interface{}:
+			m = i
+		case string:
+			if !strings.HasPrefix(i, "{") {
+				oids = append(oids, i)
+				continue
+			}
+			if err := json.Unmarshal([]byte(i), &m); err != nil {
+				return nil, nil, fmt.Errorf("unexpected policy identifier %q: %s", i, err)
+			}
+		default:
+			return nil, nil, fmt.Errorf("unexpected policy identifier %#v", v)
+		}
+
+		block := map[string]interface{}{}
+		for _, k := range []string{"oid", "cps", "notice"} {
+			if s, ok := m[k].(string); ok {
+				block[k] = s
+			}
+		}
+		blocks = append(blocks, block)
+	}
+
+	return oids, blocks, nil
+}
+
 func pkiSecretBackendRolePath(backend string, name string) string {
 	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
 }
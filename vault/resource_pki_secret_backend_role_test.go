@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -268,3 +270,434 @@ func testPkiSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestPkiSecretBackendRole_issuerFields(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_pki_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		CheckDestroy: testPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPkiSecretBackendRoleConfig_issuerFields(name, backend, `["bogus"]`, `[]`),
+				ExpectError: regexp.MustCompile(`expected cn_validations.0 to be one of`),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_issuerFields(name, backend, `["email"]`, `["foo", "bar"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer_ref", "default"),
+					resource.TestCheckResourceAttr(resourceName, "cn_validations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cn_validations.0", "email"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_user_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_user_ids.0", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_user_ids.1", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "not_before_duration", "30s"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_issuerFields(name, backend, `["hostname", "email"]`, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cn_validations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cn_validations.0", "hostname"),
+					resource.TestCheckResourceAttr(resourceName, "cn_validations.1", "email"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_user_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendRoleConfig_issuerFields(name, path, cnValidations, allowedUserIDs string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend             = vault_mount.pki.path
+  name                = "%s"
+  issuer_ref          = "default"
+  not_before_duration = "30s"
+  cn_validations      = %s
+  allowed_user_ids    = %s
+}
+`, path, name, cnValidations, allowedUserIDs)
+}
+
+func TestPkiSecretBackendRole_v111FieldsPreserved(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_pki_secret_backend_role.test"
+
+	checks := func(ttl string) resource.TestCheckFunc {
+		return resource.ComposeTestCheckFunc(
+			resource.TestCheckResourceAttr(resourceName, "ttl", ttl),
+			resource.TestCheckResourceAttr(resourceName, "allow_wildcard_certificates", "false"),
+			resource.TestCheckResourceAttr(resourceName, "issuer_ref", "default"),
+			resource.TestCheckResourceAttr(resourceName, "allowed_user_ids.#", "1"),
+			resource.TestCheckResourceAttr(resourceName, "allowed_user_ids.0", "foo"),
+			resource.TestCheckResourceAttr(resourceName, "cn_validations.#", "1"),
+			resource.TestCheckResourceAttr(resourceName, "cn_validations.0", "email"),
+			resource.TestCheckResourceAttr(resourceName, "signature_bits", "384"),
+			resource.TestCheckResourceAttr(resourceName, "use_pss", "true"),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		CheckDestroy: testPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRoleConfig_v111Fields(name, backend, 3600),
+				Check:  checks("3600"),
+			},
+			{
+				// only changing an unrelated field must not reset the fields to their defaults.
+				Config: testPkiSecretBackendRoleConfig_v111Fields(name, backend, 7200),
+				Check:  checks("7200"),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendRoleConfig_v111Fields(name, path string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend                     = vault_mount.pki.path
+  name                        = "%s"
+  ttl                         = %d
+  key_type                    = "rsa"
+  allow_wildcard_certificates = false
+  issuer_ref                  = "default"
+  allowed_user_ids            = ["foo"]
+  cn_validations              = ["email"]
+  signature_bits              = 384
+  use_pss                     = true
+}
+`, path, name, ttl)
+}
+
+func TestPkiSecretBackendRole_uriSANsTemplate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_pki_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPkiSecretBackendRoleConfig_uriSANsTemplate(name, backend, `[""]`, false, true),
+				ExpectError: regexp.MustCompile(`expected "allowed_uri_sans.0" to not be an empty string`),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_uriSANsTemplate(name, backend,
+					`["spiffe://example.com/{{identity.entity.name}}"]`, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_uri_sans.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_uri_sans.0", "spiffe://example.com/{{identity.entity.name}}"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_uri_sans_template", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allow_wildcard_certificates", "false"),
+					resource.TestCheckResourceAttr(resourceName, "require_cn", "false"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_serial_numbers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_serial_numbers.0", "abc-*"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_uriSANsTemplate(name, backend,
+					`["spiffe://example.com/*"]`, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_uri_sans.0", "spiffe://example.com/*"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_uri_sans_template", "false"),
+					resource.TestCheckResourceAttr(resourceName, "allow_wildcard_certificates", "true"),
+					resource.TestCheckResourceAttr(resourceName, "require_cn", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendRoleConfig_uriSANsTemplate(name, path, allowedURISANs string, template, wildcards bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend                     = vault_mount.pki.path
+  name                        = "%s"
+  allowed_uri_sans            = %s
+  allowed_uri_sans_template   = %t
+  allow_wildcard_certificates = %t
+  require_cn                  = %t
+  allowed_serial_numbers      = ["abc-*"]
+}
+`, path, name, allowedURISANs, template, wildcards, wildcards)
+}
+
+func TestPkiSecretBackendRole_noStore(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_pki_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRoleConfig_noStore(name, backend, "no_store = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "no_store", "true"),
+					resource.TestCheckResourceAttr(resourceName, "no_store_metadata", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_noStore(name, backend, "no_store = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "no_store", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendRole_noStoreMetadata(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_pki_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRoleConfig_noStore(name, backend, "no_store_metadata = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "no_store", "false"),
+					resource.TestCheckResourceAttr(resourceName, "no_store_metadata", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// no_store_metadata is kept when only an unrelated field changes.
+				Config: testPkiSecretBackendRoleConfig_noStore(name, backend, `
+  no_store_metadata = true
+  ttl               = 3600`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "no_store_metadata", "true"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_noStore(name, backend, "no_store_metadata = false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "no_store_metadata", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendRoleConfig_noStore(name, path, extraConfig string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend = vault_mount.pki.path
+  name    = "%s"
+  %s
+}
+`, path, name, extraConfig)
+}
+
+func TestPkiSecretBackendRole_policyIdentifier(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_pki_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			testutil.SkipTestEnvSet(t, testutil.EnvVarSkipVaultNext)
+		},
+		CheckDestroy: testPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRoleConfig_policyIdentifier(name, backend, `
+  policy_identifier {
+    oid = "1.2.3.4"
+  }
+  ext_key_usage_oids = ["1.2.3.4."]`),
+				ExpectError: regexp.MustCompile(`expected ext_key_usage_oids.0 to be a valid OID`),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_policyIdentifier(name, backend, `
+  policy_identifier {
+    oid    = "1.2.3.4"
+    cps    = "https://example.com/cps"
+    notice = "Example notice"
+  }
+  policy_identifier {
+    oid = "1.2.3.5"
+    cps = "https://example.com/cps"
+  }
+  ext_key_usage_oids = ["1.3.6.1.5.5.7.3.1", "1.3.6.1.5.5.7.3.2"]
+  key_usage          = ["DigitalSignature"]
+  signature_bits     = 384
+  use_pss            = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_identifiers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "policy_identifier.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policy_identifier.*", map[string]string{
+						"oid":    "1.2.3.4",
+						"cps":    "https://example.com/cps",
+						"notice": "Example notice",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policy_identifier.*", map[string]string{
+						"oid": "1.2.3.5",
+						"cps": "https://example.com/cps",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "ext_key_usage_oids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ext_key_usage_oids.0", "1.3.6.1.5.5.7.3.1"),
+					resource.TestCheckResourceAttr(resourceName, "ext_key_usage_oids.1", "1.3.6.1.5.5.7.3.2"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "signature_bits", "384"),
+					resource.TestCheckResourceAttr(resourceName, "use_pss", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_policyIdentifier(name, backend, `
+  policy_identifiers = ["1.2.3.4"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_identifiers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_identifiers.0", "1.2.3.4"),
+					resource.TestCheckResourceAttr(resourceName, "policy_identifier.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ext_key_usage_oids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "use_pss", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendRoleConfig_policyIdentifier(name, path, extraConfig string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend = vault_mount.pki.path
+  name    = "%s"
+%s
+}
+`, path, name, extraConfig)
+}
+
+func Test_readPKIPolicyIdentifiers(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        interface{}
+		wantOIDs   []string
+		wantBlocks []map[string]interface{}
+		wantErr    bool
+	}{
+		{
+			name: "nil",
+			raw:  nil,
+		},
+		{
+			name:     "oids",
+			raw:      []interface{}{"1.2.3.4", "1.2.3.5"},
+			wantOIDs: []string{"1.2.3.4", "1.2.3.5"},
+		},
+		{
+			name: "json-objects",
+			raw: []interface{}{
+				`{"oid":"1.2.3.4","cps":"https://example.com/cps","notice":"Example notice"}`,
+				`{"oid":"1.2.3.5"}`,
+			},
+			wantBlocks: []map[string]interface{}{
+				{"oid": "1.2.3.4", "cps": "https://example.com/cps", "notice": "Example notice"},
+				{"oid": "1.2.3.5"},
+			},
+		},
+		{
+			name: "objects",
+			raw: []interface{}{
+				map[string]interface{}{"oid": "1.2.3.4", "cps": "https://example.com/cps"},
+			},
+			wantBlocks: []map[string]interface{}{
+				{"oid": "1.2.3.4", "cps": "https://example.com/cps"},
+			},
+		},
+		{
+			name:    "invalid-json",
+			raw:     []interface{}{`{"oid":`},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oids, blocks, err := readPKIPolicyIdentifiers(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readPKIPolicyIdentifiers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(oids, tt.wantOIDs) {
+				t.Errorf("readPKIPolicyIdentifiers() oids = %v, want %v", oids, tt.wantOIDs)
+			}
+			if !reflect.DeepEqual(blocks, tt.wantBlocks) {
+				t.Errorf("readPKIPolicyIdentifiers() blocks = %v, want %v", blocks, tt.wantBlocks)
+			}
+		})
+	}
+}
@@ -113,7 +113,8 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 				Description: "List of alternative URIs.",
 				ForceNew:    true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateURISAN,
 				},
 			},
 			"other_sans": {
@@ -87,7 +87,8 @@ func pkiSecretBackendSignResource() *schema.Resource {
 				Description: "List of alternative URIs.",
 				ForceNew:    true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateURISAN,
 				},
 			},
 			"ttl": {
@@ -96,6 +97,7 @@ func pkiSecretBackendSignResource() *schema.Resource {
 				ForceNew:    false,
 				Description: "Time to live.",
 			},
+			"not_after": pkiNotAfterSchema(),
 			"format": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -203,6 +205,10 @@ func pkiSecretBackendSignCreate(d *schema.ResourceData, meta interface{}) error
 		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
 	}
 
+	if v, ok := d.GetOk("not_after"); ok {
+		data["not_after"] = v.(string)
+	}
+
 	if len(altNames) > 0 {
 		data["alt_names"] = strings.Join(altNames, ",")
 	}
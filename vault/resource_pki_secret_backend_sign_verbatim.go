@@ -0,0 +1,175 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func pkiSecretBackendSignVerbatimResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendSignVerbatimCreate,
+		Delete: pkiSecretBackendSignDelete,
+		Update: func(data *schema.ResourceData, i interface{}) error {
+			return nil
+		},
+		Read:          pkiSecretBackendCertRead,
+		CustomizeDiff: pkiCertAutoRenewCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the role to take the certificate's lifetime and key usage from when not set.",
+				ForceNew:    true,
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CSR, all of its values are used verbatim in the certificate.",
+				ForceNew:    true,
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    false,
+				Description: "Time to live.",
+			},
+			"not_after": pkiNotAfterSchema(),
+			"key_usage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specify the key usages of the certificate.",
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ext_key_usage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specify the extended key usages of the certificate.",
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The format of data.",
+				ForceNew:     true,
+				Default:      "pem",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"auto_renew": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If enabled, a new certificate will be generated if the expiration is within min_seconds_remaining",
+			},
+			"min_seconds_remaining": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     604800,
+				Description: "Generate a new certificate when the expiration is within this number of seconds",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate.",
+			},
+			"issuing_ca": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuing CA.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate's serial number, hex formatted.",
+			},
+			"expiration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The certificate expiration.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendSignVerbatimCreate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+
+	path := pkiSecretBackendSignVerbatimPath(backend, name)
+
+	data := map[string]interface{}{
+		"csr":    d.Get("csr").(string),
+		"format": d.Get("format").(string),
+	}
+
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("not_after"); ok {
+		data["not_after"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("key_usage"); ok {
+		data["key_usage"] = v.([]interface{})
+	}
+
+	if v, ok := d.GetOk("ext_key_usage"); ok {
+		data["ext_key_usage"] = v.([]interface{})
+	}
+
+	log.Printf("[DEBUG] Creating verbatim certificate sign on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating verbatim certificate sign for PKI secret backend %q: %s",
+			backend, err)
+	}
+	log.Printf("[DEBUG] Created verbatim certificate sign on PKI secret backend %q", backend)
+
+	d.Set("certificate", resp.Data["certificate"])
+	d.Set("issuing_ca", resp.Data["issuing_ca"])
+	d.Set("ca_chain", resp.Data["ca_chain"])
+	d.Set("serial_number", resp.Data["serial_number"])
+	d.Set("expiration", resp.Data["expiration"])
+
+	d.SetId(fmt.Sprintf("%s/%s", path, resp.Data["serial_number"]))
+
+	return pkiSecretBackendCertRead(d, meta)
+}
+
+func pkiSecretBackendSignVerbatimPath(backend string, name string) string {
+	path := strings.Trim(backend, "/") + "/sign-verbatim"
+	if name != "" {
+		path += "/" + strings.Trim(name, "/")
+	}
+	return path
+}
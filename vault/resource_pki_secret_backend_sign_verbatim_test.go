@@ -0,0 +1,193 @@
+package vault
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestPkiSecretBackendSignVerbatim_basic(t *testing.T) {
+	path := "pki-root-" + strconv.Itoa(acctest.RandInt())
+
+	resourceName := "vault_pki_secret_backend_sign_verbatim.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendSignVerbatimConfig_basic(path, `ttl = "1h"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", path),
+					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "1h"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ext_key_usage.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial_number"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuing_ca"),
+					testValidateCSR(resourceName),
+					testPkiSecretBackendSignVerbatimCheckExtKeyUsage(resourceName, x509.ExtKeyUsageClientAuth),
+				),
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendSignVerbatim_notAfter(t *testing.T) {
+	path := "pki-root-" + strconv.Itoa(acctest.RandInt())
+	notAfter := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	resourceName := "vault_pki_secret_backend_sign_verbatim.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testCheckMountDestroyed("vault_mount", consts.MountTypePKI, consts.FieldPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendSignVerbatimConfig_basic(path,
+					fmt.Sprintf(`not_after = %q`, notAfter.Format(time.RFC3339))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "not_after", notAfter.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "expiration", strconv.FormatInt(notAfter.Unix(), 10)),
+				),
+			},
+			{
+				Config: testPkiSecretBackendSignVerbatimConfig_basic(path,
+					`not_after = "next week"`),
+				ExpectError: regexp.MustCompile(`expected "not_after" to be a valid RFC3339 date`),
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendSignVerbatimPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend string
+		role    string
+		want    string
+	}{
+		{
+			name:    "without-role",
+			backend: "pki/",
+			want:    "pki/sign-verbatim",
+		},
+		{
+			name:    "with-role",
+			backend: "pki",
+			role:    "/test",
+			want:    "pki/sign-verbatim/test",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pkiSecretBackendSignVerbatimPath(tt.backend, tt.role); got != tt.want {
+				t.Errorf("pkiSecretBackendSignVerbatimPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testPkiSecretBackendSignVerbatimCheckExtKeyUsage(resourceName string, usage x509.ExtKeyUsage) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		b, _ := pem.Decode([]byte(rs.Primary.Attributes["certificate"]))
+		if b == nil {
+			return fmt.Errorf("failed to decode the certificate from state")
+		}
+
+		cert, err := x509.ParseCertificate(b.Bytes)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range cert.ExtKeyUsage {
+			if v == usage {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected extended key usage %v in certificate, actual %v", usage, cert.ExtKeyUsage)
+	}
+}
+
+func testPkiSecretBackendSignVerbatimConfig_basic(path, lifetime string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test root"
+  default_lease_ttl_seconds = "8640000"
+  max_lease_ttl_seconds     = "8640000"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test-root.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+  format      = "pem"
+  key_type    = "rsa"
+  key_bits    = 4096
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend = vault_pki_secret_backend_root_cert.test.backend
+  name    = "test"
+  max_ttl = "7200"
+}
+
+resource "vault_pki_secret_backend_sign_verbatim" "test" {
+  backend       = vault_mount.test-root.path
+  name          = vault_pki_secret_backend_role.test.name
+  %s
+  key_usage     = ["DigitalSignature"]
+  ext_key_usage = ["ClientAuth"]
+  csr           = <<EOT
+-----BEGIN CERTIFICATE REQUEST-----
+MIIEqDCCApACAQAwYzELMAkGA1UEBhMCQVUxEzARBgNVBAgMClNvbWUtU3RhdGUx
+ITAfBgNVBAoMGEludGVybmV0IFdpZGdpdHMgUHR5IEx0ZDEcMBoGA1UEAwwTY2Vy
+dC50ZXN0Lm15LmRvbWFpbjCCAiIwDQYJKoZIhvcNAQEBBQADggIPADCCAgoCggIB
+AJupYCQ8UVCWII1Zof1c6YcSSaM9hEaDU78cfKP5RoSeH10BvrWRfT+mzCONVpNP
+CW9Iabtvk6hm0ot6ilnndEyVJbc0g7hdDLBX5BM25D+DGZGJRKUz1V+uBrWmXtIt
+Vonj7JTDTe7ViH0GDsB7CvqXFGXO2a2cDYBchLkL6vQiFPshxvUsLtwxuy/qdYgy
+X6ya+AUoZcoQGy1XxNjfH6cPtWSWQGEp1oPR6vL9hU3laTZb3C+VV4jZem+he8/0
+V+qV6fLG92WTXm2hmf8nrtUqqJ+C7mW/RJod+TviviBadIX0OHXW7k5HVsZood01
+te8vMRUNJNiZfa9EMIK5oncbQn0LcM3Wo9VrjpL7jREb/4HCS2gswYGv7hzk9cCS
+kVY4rDucchKbApuI3kfzmO7GFOF5eiSkYZpY/czNn7VVM3WCu6dpOX4+3rhgrZQw
+kY14L930DaLVRUgve/zKVP2D2GHdEOs+MbV7s96UgigT9pXly/yHPj+1sSYqmnaD
+5b7jSeJusmzO/nrwXVGLsnezR87VzHl9Ux9g5s6zh+R+PrZuVxYsLvoUpaasH47O
+gIcBzSb/6pSGZKAUizmYsHsR1k88dAvsQ+FsUDaNokdi9VndEB4QPmiFmjyLV+0I
+1TFoXop4sW11NPz1YCq+IxnYrEaIN3PyhY0GvBJDFY1/AgMBAAGgADANBgkqhkiG
+9w0BAQsFAAOCAgEActuqnqS8Y9UF7e08w7tR3FPzGecWreuvxILrlFEZJxiLPFqL
+It7uJvtypCVQvz6UQzKdBYO7tMpRaWViB8DrWzXNZjLMrg+QHcpveg8C0Ett4scG
+fnvLk6fTDFYrnGvwHTqiHos5i0y3bFLyS1BGwSpdLAykGtvC+VM8mRyw/Y7CPcKN
+77kebY/9xduW1g2uxWLr0x90RuQDv9psPojT+59tRLGSp5Kt0IeD3QtnAZEFE4aN
+vt+Pd69eg3BgZ8ZeDgoqAw3yppvOkpAFiE5pw2qPZaM4SRphl4d2Lek2zNIMyZqv
+do5zh356HOgXtDaSg0POnRGrN/Ua+LMCRTg6GEPUnx9uQb/zt8Zu0hIexDGyykp1
+OGqtWlv/Nc8UYuS38v0BeB6bMPeoqQUjkqs8nHlAEFn0KlgYdtDC+7SdQx6wS4te
+dBKRNDfC4lS3jYJgs55jHqonZgkpSi3bamlxpfpW0ukGBcmq91wRe4bOw/4uD/vf
+UwqMWOdCYcU3mdYNjTWy22ORW3SGFQxMBwpUEURCSoeqWr6aJeQ7KAYkx1PrB5T8
+OTEc13lWf+B0PU9UJuGTsmpIuImPDVd0EVDayr3mT5dDbqTVDbe8ppf2IswABmf0
+o3DybUeUmknYjl109rdSf+76nuREICHatxXgN3xCMFuBaN4WLO+ksd6Y1Ys=
+-----END CERTIFICATE REQUEST-----
+EOT
+}
+`, path, lifetime)
+}
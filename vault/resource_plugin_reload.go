@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+// pluginReloadStatusTimeout is how long to wait for all nodes to report the
+// status of a global plugin reload.
+const pluginReloadStatusTimeout = 1 * time.Minute
+
+func pluginReloadResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pluginReloadCreate,
+		ReadContext:   pluginReloadRead,
+		DeleteContext: pluginReloadDelete,
+
+		Schema: map[string]*schema.Schema{
+			"plugin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"plugin", "mounts"},
+				Description:  "The name of the plugin to reload, as registered in the plugin catalog.",
+			},
+			"mounts": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"plugin", "mounts"},
+				Description:  "The mount paths of the plugin backends to reload.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The scope of the reload, set to 'global' to reload the plugin on all nodes of the cluster.",
+				ValidateFunc: validation.StringInSlice([]string{"global"}, false),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Description: "Arbitrary map of values that, when changed, will reload " +
+					"the plugin again, e.g. the plugin version.",
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"reload_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the reload operation, only returned for a global reload.",
+			},
+		},
+	}
+}
+
+func pluginReloadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	input := &api.ReloadPluginInput{
+		Plugin: d.Get("plugin").(string),
+		Scope:  d.Get("scope").(string),
+	}
+	for _, m := range d.Get("mounts").([]interface{}) {
+		input.Mounts = append(input.Mounts, strings.Trim(m.(string), "/"))
+	}
+
+	id := input.Plugin
+	if id == "" {
+		id = strings.Join(input.Mounts, ",")
+	}
+
+	log.Printf("[DEBUG] Reloading plugin backends %q", id)
+	reloadID, err := client.Sys().ReloadPluginWithContext(ctx, input)
+	if err != nil {
+		return diag.Errorf("error reloading plugin backends %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Reloaded plugin backends %q", id)
+
+	if reloadID != "" {
+		if err := pluginReloadWaitForStatus(ctx, client, reloadID); err != nil {
+			return diag.Errorf("error reloading plugin backends %q: %s", id, err)
+		}
+	}
+
+	if err := d.Set("reload_id", reloadID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+
+	return pluginReloadRead(ctx, d, meta)
+}
+
+// pluginReloadWaitForStatus waits until every node of the cluster, as listed
+// by sys/ha-status, has reported the status of the global reload, failing if
+// any node reports an error. When the nodes cannot be listed it only waits for
+// the first status to be reported.
+func pluginReloadWaitForStatus(ctx context.Context, client *api.Client, reloadID string) error {
+	expected := 1
+	log.Printf("[DEBUG] Reading HA status to determine the nodes of the cluster")
+	haStatus, err := client.Sys().HAStatusWithContext(ctx)
+	if err != nil {
+		log.Printf("[WARN] Unable to read HA status, only waiting for the first node "+
+			"to report the status of plugin reload %q: %s", reloadID, err)
+	} else if haStatus != nil && len(haStatus.Nodes) > expected {
+		expected = len(haStatus.Nodes)
+	}
+
+	return resource.RetryContext(ctx, pluginReloadStatusTimeout, func() *resource.RetryError {
+		log.Printf("[DEBUG] Reading plugin reload status %q", reloadID)
+		resp, err := client.Sys().ReloadPluginStatusWithContext(ctx, &api.ReloadPluginStatusInput{
+			ReloadID: reloadID,
+		})
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error reading plugin reload status %q: %s", reloadID, err))
+		}
+		log.Printf("[DEBUG] Read plugin reload status %q", reloadID)
+
+		if resp == nil || len(resp.Results) < expected {
+			return resource.RetryableError(fmt.Errorf("plugin reload %q has not completed on all %d nodes",
+				reloadID, expected))
+		}
+
+		var errs []string
+		for node, status := range resp.Results {
+			if status != nil && status.Error != "" {
+				errs = append(errs, fmt.Sprintf("%s: %s", node, status.Error))
+			}
+		}
+		if len(errs) > 0 {
+			return resource.NonRetryableError(fmt.Errorf("plugin reload %q failed on nodes: %s",
+				reloadID, strings.Join(errs, ", ")))
+		}
+
+		return nil
+	})
+}
+
+// pluginReloadRead is a no-op, a reload is a one-shot operation without any
+// state in Vault.
+func pluginReloadRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+// pluginReloadDelete only removes the resource from the state, a reload cannot
+// be undone.
+func pluginReloadDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
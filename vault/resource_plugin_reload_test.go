@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccPluginReload_mounts(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kv")
+	resourceName := "vault_plugin_reload.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_plugin_reload" "test" {
+  plugin = "kv"
+  mounts = ["%s"]
+}
+`, path),
+				ExpectError: regexp.MustCompile(`only one of .+ can be specified`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "vault_plugin_reload" "test" {
+  mounts = ["%s"]
+  scope  = "local"
+}
+`, path),
+				ExpectError: regexp.MustCompile(`expected scope to be one of \[global\]`),
+			},
+			{
+				Config: testAccPluginReloadConfig_mounts(path, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", path),
+					resource.TestCheckResourceAttr(resourceName, "mounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mounts.0", path),
+					resource.TestCheckResourceAttr(resourceName, "reload_id", ""),
+				),
+			},
+			{
+				Config: testAccPluginReloadConfig_mounts(path, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPluginReloadConfig_mounts(path, version string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+}
+
+resource "vault_plugin_reload" "test" {
+  mounts = [vault_mount.test.path]
+
+  triggers = {
+    version = "%s"
+  }
+}
+`, path, version)
+}
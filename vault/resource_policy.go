@@ -1,9 +1,11 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -11,12 +13,12 @@ import (
 
 func policyResource() *schema.Resource {
 	return &schema.Resource{
-		Create: policyWrite,
-		Update: policyWrite,
-		Delete: policyDelete,
-		Read:   policyRead,
+		CreateContext: policyWrite,
+		UpdateContext: policyWrite,
+		DeleteContext: policyDelete,
+		ReadContext:   policyRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: provider.ImportStatePassthroughNamespace,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -25,6 +27,12 @@ func policyResource() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 				Description: "Name of the policy",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					if v.(string) == vaultRootPolicyName {
+						errs = append(errs, fmt.Errorf("%s: the %q policy can not be managed", k, vaultRootPolicyName))
+					}
+					return
+				},
 			},
 
 			"policy": {
@@ -36,55 +44,77 @@ func policyResource() *schema.Resource {
 	}
 }
 
-func policyWrite(d *schema.ResourceData, meta interface{}) error {
+func policyWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	name := d.Get("name").(string)
 	policy := d.Get("policy").(string)
 
+	var diags diag.Diagnostics
+	if name == vaultDefaultPolicyName {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Overwriting Vault's %q policy", name),
+			Detail: fmt.Sprintf("The %q policy is attached to most tokens by default, "+
+				"changes to it affect effectively all clients of Vault.", name),
+		})
+	}
+
 	log.Printf("[DEBUG] Writing policy %s to Vault", name)
 	err := client.Sys().PutPolicy(name, policy)
 	if err != nil {
-		return fmt.Errorf("error writing to Vault: %s", err)
+		return append(diags, diag.Errorf("error writing to Vault: %s", err)...)
 	}
 
 	d.SetId(name)
 
-	return policyRead(d, meta)
+	return append(diags, policyRead(ctx, d, meta)...)
 }
 
-func policyDelete(d *schema.ResourceData, meta interface{}) error {
+func policyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Id()
+
+	// the default policy can not be deleted, so it is left as is.
+	if name == vaultDefaultPolicyName {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Vault's %q policy can not be deleted", name),
+				Detail: fmt.Sprintf("The %q policy was removed from the Terraform state, "+
+					"its current content is left in place in Vault.", name),
+			},
+		}
+	}
+
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
-	name := d.Id()
-
 	log.Printf("[DEBUG] Deleting policy %s from Vault", name)
 
 	err := client.Sys().DeletePolicy(name)
 	if err != nil {
-		return fmt.Errorf("error deleting from Vault: %s", err)
+		return diag.Errorf("error deleting from Vault: %s", err)
 	}
 
 	return nil
 }
 
-func policyRead(d *schema.ResourceData, meta interface{}) error {
+func policyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
-		return e
+		return diag.FromErr(e)
 	}
 
 	name := d.Id()
 
 	policy, err := client.Sys().GetPolicy(name)
 	if err != nil {
-		return fmt.Errorf("error reading from Vault: %s", err)
+		return diag.Errorf("error reading from Vault: %s", err)
 	}
 
 	d.Set("policy", policy)
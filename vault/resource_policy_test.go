@@ -2,12 +2,17 @@ package vault
 
 import (
 	"fmt"
+	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
 )
@@ -30,6 +35,185 @@ func TestResourcePolicy(t *testing.T) {
 	})
 }
 
+func TestResourcePolicy_default(t *testing.T) {
+	var original string
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			// the provider is not configured yet, so use a client from the environment.
+			client, err := api.NewClient(api.DefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+			policy, err := client.Sys().GetPolicy(vaultDefaultPolicyName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			original = policy
+		},
+		CheckDestroy: testResourcePolicy_defaultCheckDestroy(&original),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourcePolicy_namedConfig("default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_policy.test", "name", "default"),
+					resource.TestCheckResourceAttr("vault_policy.test", "policy",
+						"path \"secret/*\" {\n\tpolicy = \"read\"\n}\n"),
+				),
+			},
+			{
+				Config:      testResourcePolicy_namedConfig("root"),
+				ExpectError: regexp.MustCompile(`the "root" policy can not be managed`),
+			},
+		},
+	})
+}
+
+func TestResourcePolicy_namespace(t *testing.T) {
+	ns := acctest.RandomWithPrefix("ns")
+	name := acctest.RandomWithPrefix("test-")
+	resourceName := "vault_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestEntPreCheck(t) },
+		CheckDestroy: testResourcePolicy_checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourcePolicy_namespaceConfig(ns, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "namespace", ns),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					testResourcePolicy_checkNamespaced(resourceName, ns),
+				),
+			},
+			{
+				PreConfig: func() {
+					t.Setenv(consts.EnvVarVaultNamespaceImport, ns)
+				},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// needed for the import step above
+				Config: testResourcePolicy_namespaceConfig(ns, name),
+				PreConfig: func() {
+					os.Unsetenv(consts.EnvVarVaultNamespaceImport)
+				},
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testResourcePolicy_namespaceConfig(ns, name string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = "%s"
+}
+
+resource "vault_policy" "test" {
+  namespace = vault_namespace.test.path
+  name      = "%s"
+  policy    = <<EOT
+path "secret/*" {
+  policy = "read"
+}
+EOT
+}
+`, ns, name)
+}
+
+// testResourcePolicy_checkNamespaced ensures that the policy only exists in the namespace.
+func testResourcePolicy_checkNamespaced(resourceName, ns string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		meta := testProvider.Meta().(*provider.ProviderMeta)
+		nsClient, err := meta.GetNSClient(ns)
+		if err != nil {
+			return err
+		}
+
+		if policy, err := nsClient.Sys().GetPolicy(rs.Primary.ID); err != nil {
+			return err
+		} else if policy == "" {
+			return fmt.Errorf("policy %q not found in namespace %q", rs.Primary.ID, ns)
+		}
+
+		if policy, err := meta.GetClient().Sys().GetPolicy(rs.Primary.ID); err != nil {
+			return err
+		} else if policy != "" {
+			return fmt.Errorf("policy %q unexpectedly found outside of namespace %q", rs.Primary.ID, ns)
+		}
+
+		return nil
+	}
+}
+
+func testResourcePolicy_checkDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_policy" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		policy, err := client.Sys().GetPolicy(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if policy != "" {
+			return fmt.Errorf("policy %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+// testResourcePolicy_defaultCheckDestroy ensures that the default policy was
+// left in place, and then restores its original content for the other tests.
+func testResourcePolicy_defaultCheckDestroy(original *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+		policy, err := client.Sys().GetPolicy(vaultDefaultPolicyName)
+		if err != nil {
+			return fmt.Errorf("error reading back policy: %s", err)
+		}
+
+		if err := client.Sys().PutPolicy(vaultDefaultPolicyName, *original); err != nil {
+			return fmt.Errorf("error restoring the original default policy: %s", err)
+		}
+
+		if !strings.Contains(policy, `path "secret/*"`) {
+			return fmt.Errorf("default policy was not left in place, got %q", policy)
+		}
+
+		return nil
+	}
+}
+
+func testResourcePolicy_namedConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vault_policy" "test" {
+	name = "%s"
+	policy = <<EOT
+path "secret/*" {
+	policy = "read"
+}
+EOT
+}
+`, name)
+}
+
 func testResourcePolicy_initialConfig(name string) string {
 	return fmt.Sprintf(`
 resource "vault_policy" "test" {
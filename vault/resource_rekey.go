@@ -0,0 +1,415 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const rekeyPath = "sys/rekey"
+
+func rekeyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: rekeyCreate,
+		UpdateContext: rekeyUpdate,
+		ReadContext:   rekeyRead,
+		DeleteContext: rekeyDelete,
+		CustomizeDiff: rekeyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"secret_shares": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The number of key shares to split the new root key into.",
+				ValidateFunc: validation.IntBetween(1, 255),
+			},
+			"secret_threshold": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The number of new key shares required to reconstruct the root key.",
+				ValidateFunc: validation.IntBetween(1, 255),
+			},
+			"pgp_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Description: "Base64-encoded PGP public keys used to encrypt the new key shares, " +
+					"one per share.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsBase64,
+				},
+			},
+			"backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Store a backup of the PGP encrypted key shares in Vault's core.",
+			},
+			"require_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Description: "Require the new key shares to be provided via verification_key_shares " +
+					"before the rekey takes effect.",
+			},
+			"key_shares": {
+				Type:      schema.TypeList,
+				Optional:  true,
+				Sensitive: true,
+				Description: "The current unseal key shares to provide to the rekey, " +
+					"additional shares can be provided on subsequent applies.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"verification_key_shares": {
+				Type:      schema.TypeList,
+				Optional:  true,
+				Sensitive: true,
+				Description: "The new unseal key shares to provide to the rekey verification, " +
+					"only used when require_verification is true.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"nonce": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The nonce of the rekey attempt.",
+			},
+			"progress": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of current key shares provided so far.",
+			},
+			"required": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of current key shares required to complete the rekey.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The new key shares, hex-encoded or PGP encrypted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"keys_base64": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The new key shares, base64-encoded or PGP encrypted.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"pgp_fingerprints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The fingerprints of the PGP keys used to encrypt the new key shares.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"verification_nonce": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The nonce of the rekey verification, set once the new key shares have been generated and cleared once verified.",
+			},
+			"verification_progress": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of new key shares provided to the verification so far.",
+			},
+			"complete": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True once the new key shares are in effect.",
+			},
+		},
+	}
+}
+
+func rekeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	status, err := client.Sys().RekeyStatus()
+	if err != nil {
+		return diag.Errorf("error reading rekey status from %q: %s", rekeyPath, err)
+	}
+	if status.Started {
+		return diag.Errorf("a rekey is already in progress with nonce %q, "+
+			"it must be completed or cancelled first", status.Nonce)
+	}
+
+	var pgpKeys []string
+	for _, v := range d.Get("pgp_keys").([]interface{}) {
+		pgpKeys = append(pgpKeys, v.(string))
+	}
+
+	log.Printf("[WARN] Starting rekey via %q", rekeyPath)
+	status, err = client.Sys().RekeyInit(&api.RekeyInitRequest{
+		SecretShares:        d.Get("secret_shares").(int),
+		SecretThreshold:     d.Get("secret_threshold").(int),
+		PGPKeys:             pgpKeys,
+		Backup:              d.Get("backup").(bool),
+		RequireVerification: d.Get("require_verification").(bool),
+	})
+	if err != nil {
+		return diag.Errorf("error starting rekey via %q: %s", rekeyPath, err)
+	}
+	log.Printf("[DEBUG] Started rekey via %q", rekeyPath)
+
+	d.SetId(status.Nonce)
+	if err := d.Set("nonce", status.Nonce); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := rekeySetStatus(d, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return rekeyProvideKeyShares(ctx, d, meta, client,
+		d.Get("key_shares").([]interface{}), d.Get("verification_key_shares").([]interface{}))
+}
+
+func rekeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	if d.Get("complete").(bool) {
+		return rekeyRead(ctx, d, meta)
+	}
+
+	return rekeyProvideKeyShares(ctx, d, meta, client,
+		newKeyShares(d, "key_shares"), newKeyShares(d, "verification_key_shares"))
+}
+
+// rekeyProvideKeyShares drives the rekey through its update and,
+// when required, verification steps with the given key shares.
+func rekeyProvideKeyShares(ctx context.Context, d *schema.ResourceData, meta interface{},
+	client *api.Client, shares, verificationShares []interface{},
+) diag.Diagnostics {
+	nonce := d.Id()
+	// the new key shares are only generated once, they are kept in state from then on.
+	for _, share := range shares {
+		if d.Get("verification_nonce").(string) != "" || d.Get("complete").(bool) {
+			break
+		}
+
+		log.Printf("[DEBUG] Providing key share to rekey %q", nonce)
+		resp, err := client.Sys().RekeyUpdate(share.(string), nonce)
+		if err != nil {
+			return diag.Errorf("error providing key share to rekey %q: %s", nonce, err)
+		}
+
+		if !resp.Complete {
+			status, err := client.Sys().RekeyStatus()
+			if err != nil {
+				return diag.Errorf("error reading rekey status from %q: %s", rekeyPath, err)
+			}
+			if err := rekeySetStatus(d, status); err != nil {
+				return diag.FromErr(err)
+			}
+			log.Printf("[DEBUG] Provided key share to rekey %q, progress %d/%d",
+				nonce, status.Progress, status.Required)
+			continue
+		}
+
+		log.Printf("[DEBUG] Provided key share to rekey %q, new key shares generated", nonce)
+		fields := map[string]interface{}{
+			"progress":           d.Get("required"),
+			"keys":               resp.Keys,
+			"keys_base64":        resp.KeysB64,
+			"pgp_fingerprints":   resp.PGPFingerprints,
+			"verification_nonce": resp.VerificationNonce,
+			"complete":           !resp.VerificationRequired,
+		}
+		for k, v := range fields {
+			if err := d.Set(k, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	verificationNonce := d.Get("verification_nonce").(string)
+	if verificationNonce == "" {
+		return nil
+	}
+
+	for _, share := range verificationShares {
+		if d.Get("complete").(bool) {
+			break
+		}
+
+		log.Printf("[DEBUG] Providing key share to rekey verification %q", verificationNonce)
+		resp, err := client.Sys().RekeyVerificationUpdate(share.(string), verificationNonce)
+		if err != nil {
+			return diag.Errorf("error providing key share to rekey verification %q: %s", verificationNonce, err)
+		}
+
+		if resp.Complete {
+			log.Printf("[DEBUG] Provided key share to rekey verification %q, new key shares in effect", verificationNonce)
+			if diags := rekeySetVerificationComplete(d); diags.HasError() {
+				return diags
+			}
+			continue
+		}
+
+		status, err := client.Sys().RekeyVerificationStatus()
+		if err != nil {
+			return diag.Errorf("error reading rekey verification status from %q: %s", rekeyPath, err)
+		}
+		if err := d.Set("verification_progress", status.Progress); err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("[DEBUG] Provided key share to rekey verification %q, progress %d/%d",
+			verificationNonce, status.Progress, status.T)
+	}
+
+	return nil
+}
+
+func rekeyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the attempt's status is no longer available from Vault once completed.
+	if d.Get("complete").(bool) {
+		return nil
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	if verificationNonce := d.Get("verification_nonce").(string); verificationNonce != "" {
+		status, err := client.Sys().RekeyVerificationStatus()
+		if err != nil {
+			return diag.Errorf("error reading rekey verification status from %q: %s", rekeyPath, err)
+		}
+		if !status.Started || status.Nonce != verificationNonce {
+			// the verification was completed, cancelled or restarted outside of
+			// Terraform, so the new key shares in state can not be relied upon.
+			log.Printf("[WARN] Rekey verification %q is no longer in progress, removing from state", verificationNonce)
+			d.SetId("")
+			return nil
+		}
+		if err := d.Set("verification_progress", status.Progress); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+
+	status, err := client.Sys().RekeyStatus()
+	if err != nil {
+		return diag.Errorf("error reading rekey status from %q: %s", rekeyPath, err)
+	}
+	if !status.Started || status.Nonce != d.Id() {
+		log.Printf("[WARN] Rekey %q is no longer in progress, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := rekeySetStatus(d, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func rekeyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("complete").(bool) {
+		log.Printf("[WARN] Removing completed rekey %q from state, the new key shares remain in effect", d.Id())
+		return nil
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	status, err := client.Sys().RekeyStatus()
+	if err != nil {
+		return diag.Errorf("error reading rekey status from %q: %s", rekeyPath, err)
+	}
+	if !status.Started || status.Nonce != d.Id() {
+		return nil
+	}
+
+	// cancelling the rekey also discards any pending verification.
+	log.Printf("[DEBUG] Cancelling rekey %q", d.Id())
+	if err := client.Sys().RekeyCancel(); err != nil {
+		return diag.Errorf("error cancelling rekey %q: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Cancelled rekey %q", d.Id())
+
+	return nil
+}
+
+func rekeyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("secret_shares") {
+		return nil
+	}
+
+	shares := d.Get("secret_shares").(int)
+	threshold := d.Get("secret_threshold").(int)
+	if d.NewValueKnown("secret_threshold") && threshold > shares {
+		return fmt.Errorf("secret_threshold (%d) must be less than or equal to secret_shares (%d)",
+			threshold, shares)
+	}
+
+	if !d.NewValueKnown("pgp_keys") {
+		return nil
+	}
+	if pgpKeys := d.Get("pgp_keys").([]interface{}); len(pgpKeys) > 0 && len(pgpKeys) != shares {
+		return fmt.Errorf("the number of pgp_keys (%d) must match secret_shares (%d)",
+			len(pgpKeys), shares)
+	}
+
+	return nil
+}
+
+func rekeySetStatus(d *schema.ResourceData, status *api.RekeyStatusResponse) error {
+	fields := map[string]interface{}{
+		"progress": status.Progress,
+		"required": status.Required,
+	}
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rekeySetVerificationComplete marks the rekey as complete once the new key
+// shares have been verified.
+func rekeySetVerificationComplete(d *schema.ResourceData) diag.Diagnostics {
+	for k, v := range map[string]interface{}{
+		"verification_nonce": "",
+		"complete":           true,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+const (
+	// Rekeying is a cluster wide operation that changes the unseal keys,
+	// so the tests only run when explicitly enabled.
+	envVarTestRekey = "TF_ACC_REKEY"
+	// envVarTestRekeyKeyShares holds the comma separated current unseal key
+	// shares, the new key shares are written to the file in
+	// envVarTestRekeyNewKeySharesFile once the verification test has completed.
+	envVarTestRekeyKeyShares        = "TF_ACC_REKEY_KEY_SHARES"
+	envVarTestRekeyNewKeySharesFile = "TF_ACC_REKEY_NEW_KEY_SHARES_FILE"
+)
+
+func TestResourceRekey(t *testing.T) {
+	testutil.SkipTestEnvUnset(t, envVarTestRekey)
+
+	resourceName := "vault_rekey.test"
+	var nonce string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testResourceRekeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testResourceRekeyConfig_init(2, 3, ""),
+				ExpectError: regexp.MustCompile(`secret_threshold \(3\) must be less than or equal to secret_shares \(2\)`),
+			},
+			{
+				Config:      testResourceRekeyConfig_init(2, 1, `pgp_keys = ["dGVzdA=="]`),
+				ExpectError: regexp.MustCompile(`the number of pgp_keys \(1\) must match secret_shares \(2\)`),
+			},
+			{
+				Config: testResourceRekeyConfig_init(5, 3, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "nonce"),
+					resource.TestCheckResourceAttr(resourceName, "progress", "0"),
+					resource.TestCheckResourceAttr(resourceName, "complete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "verification_nonce", ""),
+					testResourceRekeySaveNonce(resourceName, &nonce),
+				),
+			},
+			{
+				// a rekey that was cancelled outside of Terraform is recreated.
+				PreConfig: func() {
+					client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+					if err := client.Sys().RekeyCancel(); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testResourceRekeyConfig_init(5, 3, ""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testResourceRekeyConfig_init(5, 3, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "nonce"),
+					testResourceRekeyCheckNonceChanged(resourceName, &nonce),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRekey_verification(t *testing.T) {
+	values := testutil.SkipTestEnvUnset(t, envVarTestRekey, envVarTestRekeyKeyShares,
+		envVarTestRekeyNewKeySharesFile)
+	keyShares, newKeySharesFile := strings.Split(values[1], ","), values[2]
+
+	resourceName := "vault_rekey.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testResourceRekeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceRekeyConfig_verification(keyShares, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "progress", strconv.Itoa(len(keyShares))),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "verification_nonce"),
+					resource.TestCheckResourceAttr(resourceName, "verification_progress", "0"),
+					resource.TestCheckResourceAttr(resourceName, "complete", "false"),
+					testResourceRekeySaveNewKeyShares(resourceName, newKeySharesFile),
+				),
+			},
+			{
+				Config: testResourceRekeyConfig_verification(keyShares,
+					fmt.Sprintf("verification_key_shares = split(\",\", file(%q))", newKeySharesFile)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "verification_nonce", ""),
+					resource.TestCheckResourceAttr(resourceName, "complete", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceRekeySaveNonce(resourceName string, nonce *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		*nonce = rs.Primary.Attributes["nonce"]
+		return nil
+	}
+}
+
+func testResourceRekeyCheckNonceChanged(resourceName string, nonce *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if actual := rs.Primary.Attributes["nonce"]; actual == *nonce {
+			return fmt.Errorf("expected a new rekey to be started, nonce %q is unchanged", actual)
+		}
+		return nil
+	}
+}
+
+// testResourceRekeySaveNewKeyShares writes the new key shares to filename, they
+// are needed for the verification, and to unseal Vault once the test has completed.
+func testResourceRekeySaveNewKeyShares(resourceName, filename string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["keys.#"])
+		if err != nil {
+			return err
+		}
+
+		var keys []string
+		for i := 0; i < count; i++ {
+			keys = append(keys, rs.Primary.Attributes[fmt.Sprintf("keys.%d", i)])
+		}
+
+		return os.WriteFile(filename, []byte(strings.Join(keys, ",")), 0o600)
+	}
+}
+
+func testResourceRekeyCheckDestroy(_ *terraform.State) error {
+	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+	status, err := client.Sys().RekeyStatus()
+	if err != nil {
+		return err
+	}
+	if status.Started {
+		return fmt.Errorf("rekey %q is still in progress", status.Nonce)
+	}
+
+	return nil
+}
+
+func testResourceRekeyConfig_init(shares, threshold int, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_rekey" "test" {
+  secret_shares        = %d
+  secret_threshold     = %d
+  require_verification = true
+  %s
+}
+`, shares, threshold, extra)
+}
+
+func testResourceRekeyConfig_verification(keyShares []string, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_rekey" "test" {
+  secret_shares        = 1
+  secret_threshold     = 1
+  require_verification = true
+  key_shares           = ["%s"]
+  %s
+}
+`, strings.Join(keyShares, `", "`), extra)
+}
@@ -0,0 +1,313 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/roottoken"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const generateRootPath = "sys/generate-root"
+
+func rootTokenGenerationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: rootTokenGenerationCreate,
+		UpdateContext: rootTokenGenerationUpdate,
+		ReadContext:   rootTokenGenerationRead,
+		DeleteContext: rootTokenGenerationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"confirm_root_token_generation": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+				Description: "Must be set to true to confirm that a new root token should be generated, " +
+					"guards against accidentally starting a root token generation.",
+				ValidateFunc: validateRootTokenGenerationConfirmed,
+			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "A base64-encoded PGP public key used to encrypt the generated root token, " +
+					"the root token is then never stored in plain-text.",
+				ValidateFunc: validation.StringIsBase64,
+				ExactlyOneOf: []string{"pgp_key", "otp"},
+			},
+			"otp": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				Description: "A one-time password used to encode the generated root token, " +
+					"the decoded root token is stored in token.",
+				ExactlyOneOf: []string{"pgp_key", "otp"},
+			},
+			"key_shares": {
+				Type:      schema.TypeList,
+				Optional:  true,
+				Sensitive: true,
+				Description: "Unseal or recovery key shares to provide to the root token generation, " +
+					"additional shares can be provided on subsequent applies.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"nonce": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The nonce of the root token generation attempt.",
+			},
+			"progress": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of key shares provided so far.",
+			},
+			"required": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of key shares required to complete the root token generation.",
+			},
+			"complete": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True once the root token has been generated.",
+			},
+			"pgp_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fingerprint of the PGP key used to encrypt the generated root token.",
+			},
+			"encoded_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The encoded, or PGP encrypted, root token.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated root token, decoded with the otp. Unset when pgp_key is set.",
+			},
+		},
+	}
+}
+
+func rootTokenGenerationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	status, err := client.Sys().GenerateRootStatus()
+	if err != nil {
+		return diag.Errorf("error reading root token generation status from %q: %s", generateRootPath, err)
+	}
+	if status.Started {
+		return diag.Errorf("a root token generation is already in progress with nonce %q, "+
+			"it must be completed or cancelled first", status.Nonce)
+	}
+
+	log.Printf("[WARN] Starting root token generation via %q", generateRootPath)
+	status, err = client.Sys().GenerateRootInit(d.Get("otp").(string), d.Get("pgp_key").(string))
+	if err != nil {
+		return diag.Errorf("error starting root token generation via %q: %s", generateRootPath, err)
+	}
+	log.Printf("[DEBUG] Started root token generation via %q", generateRootPath)
+
+	d.SetId(status.Nonce)
+	if err := d.Set("nonce", status.Nonce); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return rootTokenGenerationProvideKeyShares(ctx, d, meta, client, status, d.Get("key_shares").([]interface{}))
+}
+
+func rootTokenGenerationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	if !d.HasChange("key_shares") || d.Get("complete").(bool) {
+		return rootTokenGenerationRead(ctx, d, meta)
+	}
+
+	shares := newKeyShares(d, "key_shares")
+	status, err := client.Sys().GenerateRootStatus()
+	if err != nil {
+		return diag.Errorf("error reading root token generation status from %q: %s", generateRootPath, err)
+	}
+
+	return rootTokenGenerationProvideKeyShares(ctx, d, meta, client, status, shares)
+}
+
+func rootTokenGenerationProvideKeyShares(ctx context.Context, d *schema.ResourceData, meta interface{},
+	client *api.Client, status *api.GenerateRootStatusResponse, shares []interface{},
+) diag.Diagnostics {
+	nonce := d.Id()
+	for _, share := range shares {
+		if status.Complete {
+			break
+		}
+
+		log.Printf("[DEBUG] Providing key share to root token generation %q", nonce)
+		var err error
+		status, err = client.Sys().GenerateRootUpdate(share.(string), nonce)
+		if err != nil {
+			return diag.Errorf("error providing key share to root token generation %q: %s", nonce, err)
+		}
+		log.Printf("[DEBUG] Provided key share to root token generation %q, progress %d/%d",
+			nonce, status.Progress, status.Required)
+	}
+
+	if err := rootTokenGenerationSetStatus(d, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !status.Complete {
+		return nil
+	}
+
+	encoded := status.EncodedToken
+	if encoded == "" {
+		encoded = status.EncodedRootToken
+	}
+	if err := d.Set("encoded_token", encoded); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if otp := d.Get("otp").(string); otp != "" {
+		token, err := roottoken.DecodeToken(encoded, otp, rootTokenGenerationOTPLength(otp))
+		if err != nil {
+			return diag.Errorf("error decoding generated root token: %s", err)
+		}
+		if err := d.Set("token", token); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[WARN] Root token generation %q complete, the generated root token should be revoked once no longer needed", nonce)
+
+	return nil
+}
+
+func rootTokenGenerationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the attempt's status is no longer available from Vault once completed.
+	if d.Get("complete").(bool) {
+		return nil
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	status, err := client.Sys().GenerateRootStatus()
+	if err != nil {
+		return diag.Errorf("error reading root token generation status from %q: %s", generateRootPath, err)
+	}
+
+	if !status.Started || status.Nonce != d.Id() {
+		log.Printf("[WARN] Root token generation %q is no longer in progress, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := rootTokenGenerationSetStatus(d, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func rootTokenGenerationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("complete").(bool) {
+		log.Printf("[WARN] Removing completed root token generation %q from state, "+
+			"the generated root token is not revoked", d.Id())
+		return nil
+	}
+
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	status, err := client.Sys().GenerateRootStatus()
+	if err != nil {
+		return diag.Errorf("error reading root token generation status from %q: %s", generateRootPath, err)
+	}
+	if !status.Started || status.Nonce != d.Id() {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling root token generation %q", d.Id())
+	if err := client.Sys().GenerateRootCancel(); err != nil {
+		return diag.Errorf("error cancelling root token generation %q: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Cancelled root token generation %q", d.Id())
+
+	return nil
+}
+
+func rootTokenGenerationSetStatus(d *schema.ResourceData, status *api.GenerateRootStatusResponse) error {
+	fields := map[string]interface{}{
+		"progress":        status.Progress,
+		"required":        status.Required,
+		"complete":        status.Complete,
+		"pgp_fingerprint": status.PGPFingerprint,
+	}
+	for k, v := range fields {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newKeyShares returns the key shares in field k that were not provided on a previous apply.
+func newKeyShares(d *schema.ResourceData, k string) []interface{} {
+	o, n := d.GetChange(k)
+	provided := map[string]bool{}
+	for _, v := range o.([]interface{}) {
+		provided[v.(string)] = true
+	}
+
+	var shares []interface{}
+	for _, v := range n.([]interface{}) {
+		if !provided[v.(string)] {
+			shares = append(shares, v)
+		}
+	}
+
+	return shares
+}
+
+// rootTokenGenerationOTPLength returns the OTP length expected by
+// roottoken.DecodeToken, which is 0 for the base64-encoded 128-bit OTPs used
+// before Vault 1.10.
+func rootTokenGenerationOTPLength(otp string) int {
+	if b, err := base64.StdEncoding.DecodeString(otp); err == nil && len(b) == 16 {
+		return 0
+	}
+	return len(otp)
+}
+
+// validateRootTokenGenerationConfirmed ensures that the root token generation
+// has been explicitly opted into.
+func validateRootTokenGenerationConfirmed(i interface{}, k string) ([]string, []error) {
+	if v, ok := i.(bool); !ok || !v {
+		return nil, []error{fmt.Errorf("%s must be set to true to generate a root token", k)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,193 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/helper/pgpkeys"
+	"github.com/hashicorp/vault/sdk/helper/roottoken"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+// Root token generation is a cluster wide operation,
+// so these tests only run when explicitly enabled.
+const envVarTestGenerateRoot = "TF_ACC_GENERATE_ROOT"
+
+func TestResourceRootTokenGeneration(t *testing.T) {
+	testutil.SkipTestEnvUnset(t, envVarTestGenerateRoot)
+
+	resourceName := "vault_root_token_generation.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testResourceRootTokenGenerationCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testResourceRootTokenGenerationConfig(false, ""),
+				ExpectError: regexp.MustCompile(`confirm_root_token_generation must be set to true`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "vault_root_token_generation" "test" {
+  confirm_root_token_generation = true
+  pgp_key                       = %q
+  otp                           = "otp"
+}
+`, strings.Join(strings.Fields(pgpkeys.TestPubKey1), "")),
+				ExpectError: regexp.MustCompile("only one of `otp,pgp_key` can be specified"),
+			},
+			{
+				Config: testResourceRootTokenGenerationConfig(true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "nonce"),
+					resource.TestCheckResourceAttrSet(resourceName, "pgp_fingerprint"),
+					resource.TestCheckResourceAttr(resourceName, "progress", "0"),
+					resource.TestCheckResourceAttr(resourceName, "complete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "encoded_token", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRootTokenGeneration_complete(t *testing.T) {
+	testutil.SkipTestEnvUnset(t, envVarTestGenerateRoot)
+	values := testutil.SkipTestEnvUnset(t, "VAULT_UNSEAL_KEY")
+	unsealKey := values[0]
+
+	resourceName := "vault_root_token_generation.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceRootTokenGenerationConfig(true, fmt.Sprintf("%q", unsealKey)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "nonce"),
+					resource.TestCheckResourceAttr(resourceName, "complete", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "encoded_token"),
+					testResourceRootTokenGenerationRevoke(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceRootTokenGeneration_completeOTP(t *testing.T) {
+	testutil.SkipTestEnvUnset(t, envVarTestGenerateRoot)
+	values := testutil.SkipTestEnvUnset(t, "VAULT_UNSEAL_KEY")
+	unsealKey := values[0]
+
+	// Vault 1.10+ expects the OTP to be as long as its root tokens.
+	otp, err := roottoken.GenerateOTP(28)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceName := "vault_root_token_generation.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_root_token_generation" "test" {
+  confirm_root_token_generation = true
+  otp                           = %q
+  key_shares                    = [%q]
+}
+`, otp, unsealKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "complete", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "encoded_token"),
+					resource.TestCheckResourceAttr(resourceName, "pgp_fingerprint", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources[resourceName]
+						if !ok {
+							return fmt.Errorf("resource %q not found in state", resourceName)
+						}
+
+						client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+						return client.Auth().Token().RevokeTree(rs.Primary.Attributes["token"])
+					},
+				),
+			},
+		},
+	})
+}
+
+func Test_rootTokenGenerationOTPLength(t *testing.T) {
+	tests := []struct {
+		name string
+		otp  string
+		want int
+	}{
+		{
+			name: "base62",
+			otp:  "4hEjngMjxXMkdLfu8LY0VSFjMiFv",
+			want: 28,
+		},
+		{
+			name: "base64-128-bit",
+			otp:  "5Mb0Ly6KRgTx5Wpx0OaZZw==",
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rootTokenGenerationOTPLength(tt.otp); got != tt.want {
+				t.Errorf("rootTokenGenerationOTPLength() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testResourceRootTokenGenerationCheckDestroy(_ *terraform.State) error {
+	client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+	status, err := client.Sys().GenerateRootStatus()
+	if err != nil {
+		return err
+	}
+	if status.Started {
+		return fmt.Errorf("root token generation %q is still in progress", status.Nonce)
+	}
+
+	return nil
+}
+
+func testResourceRootTokenGenerationRevoke(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+
+		token, err := pgpkeys.DecryptBytes(rs.Primary.Attributes["encoded_token"], pgpkeys.TestPrivKey1)
+		if err != nil {
+			return fmt.Errorf("error decrypting the generated root token: %s", err)
+		}
+
+		client := testProvider.Meta().(*provider.ProviderMeta).GetClient()
+
+		return client.Auth().Token().RevokeTree(token.String())
+	}
+}
+
+func testResourceRootTokenGenerationConfig(confirm bool, keyShares string) string {
+	return fmt.Sprintf(`
+resource "vault_root_token_generation" "test" {
+  confirm_root_token_generation = %t
+  pgp_key                       = %q
+  key_shares                    = [%s]
+}
+`, confirm, strings.Join(strings.Fields(pgpkeys.TestPubKey1), ""), keyShares)
+}
@@ -0,0 +1,128 @@
+package vault
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func sshSecretBackendZeroAddressResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: sshSecretBackendZeroAddressWrite,
+		ReadContext:   sshSecretBackendZeroAddressRead,
+		UpdateContext: sshSecretBackendZeroAddressWrite,
+		DeleteContext: sshSecretBackendZeroAddressDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ssh",
+				ForceNew:    true,
+				Description: "The path of the SSH Secret Backend where the zero-address roles should be configured.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The names of the roles that are allowed to request credentials for any host, regardless of their CIDR list.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func sshSecretBackendZeroAddressWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := sshSecretBackendZeroAddressPath(backend)
+
+	data := map[string]interface{}{
+		"roles": util.TerraformSetToStringArray(d.Get("roles")),
+	}
+
+	log.Printf("[DEBUG] Writing zero-address roles to %q", path)
+	if _, err := client.Logical().WriteWithContext(ctx, path, data); err != nil {
+		return diag.Errorf("error writing zero-address roles to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote zero-address roles to %q", path)
+
+	d.SetId(backend)
+
+	return sshSecretBackendZeroAddressRead(ctx, d, meta)
+}
+
+func sshSecretBackendZeroAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Id()
+	path := sshSecretBackendZeroAddressPath(backend)
+
+	log.Printf("[DEBUG] Reading zero-address roles from %q", path)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil && !util.Is404(err) {
+		return diag.Errorf("error reading zero-address roles from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read zero-address roles from %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Zero-address roles not found at %q, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", backend); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("roles", resp.Data["roles"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func sshSecretBackendZeroAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := sshSecretBackendZeroAddressPath(d.Id())
+
+	log.Printf("[DEBUG] Deleting zero-address roles from %q", path)
+	if _, err := client.Logical().DeleteWithContext(ctx, path); err != nil {
+		return diag.Errorf("error deleting zero-address roles from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted zero-address roles from %q", path)
+
+	return nil
+}
+
+func sshSecretBackendZeroAddressPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/zeroaddress"
+}
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccSSHSecretBackendZeroAddress_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ssh")
+	resourceName := "vault_ssh_secret_backend_zeroaddress.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckSSHSecretBackendZeroAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendZeroAddressConfig(backend, `vault_ssh_secret_backend_role.otp1.name`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "otp1"),
+				),
+			},
+			{
+				Config: testAccSSHSecretBackendZeroAddressConfig(backend,
+					`vault_ssh_secret_backend_role.otp1.name`, `vault_ssh_secret_backend_role.otp2.name`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "otp1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "otp2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSSHSecretBackendZeroAddressDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ssh_secret_backend_zeroaddress" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		path := sshSecretBackendZeroAddressPath(rs.Primary.ID)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			// the mount is gone as well
+			continue
+		}
+		if resp != nil {
+			return fmt.Errorf("zero-address roles still configured at %q", path)
+		}
+	}
+	return nil
+}
+
+func testAccSSHSecretBackendZeroAddressConfig(backend string, roles ...string) string {
+	ret := fmt.Sprintf(`
+resource "vault_mount" "ssh" {
+  path = "%s"
+  type = "ssh"
+}
+`, backend)
+
+	for _, name := range []string{"otp1", "otp2"} {
+		ret += fmt.Sprintf(`
+resource "vault_ssh_secret_backend_role" "%s" {
+  name         = "%s"
+  backend      = vault_mount.ssh.path
+  key_type     = "otp"
+  default_user = "usr"
+  cidr_list    = "10.0.0.0/8"
+}
+`, name, name)
+	}
+
+	ret += fmt.Sprintf(`
+resource "vault_ssh_secret_backend_zeroaddress" "test" {
+  backend = vault_mount.ssh.path
+  roles   = [%s]
+}
+`, strings.Join(roles, ", "))
+
+	return ret
+}
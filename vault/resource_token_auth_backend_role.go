@@ -25,6 +25,7 @@ func tokenAuthBackendRoleTokenConfig() *addTokenFieldsConfig {
 		TokenTTLConflict:    []string{"token_period"},
 
 		TokenTypeDefault: "default-service",
+		TokenTypes:       tokenStoreTokenTypes,
 	}
 }
 
@@ -198,9 +199,7 @@ func tokenAuthBackendRoleRead(_ context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	diags := checkCIDRs(d, TokenFieldBoundCIDRs)
-
-	return diags
+	return nil
 }
 
 func tokenAuthBackendRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
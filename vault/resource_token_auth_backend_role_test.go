@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -73,6 +74,8 @@ func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "token_bound_cidrs.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "token_bound_cidrs.0", "0.0.0.0/0"),
 					resource.TestCheckResourceAttr(resourceName, "token_type", "default-batch"),
+					resource.TestCheckResourceAttr(resourceName, "token_no_default_policy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "token_num_uses", "10"),
 				),
 			},
 			{
@@ -101,6 +104,8 @@ func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "token_bound_cidrs.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "token_bound_cidrs.0", "0.0.0.0/0"),
 					resource.TestCheckResourceAttr(resourceName, "token_type", "default-batch"),
+					resource.TestCheckResourceAttr(resourceName, "token_no_default_policy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "token_num_uses", "10"),
 				),
 			},
 			{
@@ -118,6 +123,8 @@ func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "path_suffix", ""),
 					resource.TestCheckResourceAttr(resourceName, "token_bound_cidrs.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "token_type", "default-service"),
+					resource.TestCheckResourceAttr(resourceName, "token_no_default_policy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "token_num_uses", "0"),
 				),
 			},
 			{
@@ -125,6 +132,10 @@ func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config:      testAccTokenAuthBackendRoleConfigInvalidCIDR(roleUpdated),
+				ExpectError: regexp.MustCompile(`to be a valid CIDR block, got "10.1.1.1"`),
+			},
 		},
 	})
 }
@@ -245,6 +256,17 @@ resource "vault_token_auth_backend_role" "role" {
   path_suffix              = "parth-suffix"
   token_bound_cidrs        = ["0.0.0.0/0"]
   token_type               = "default-batch"
+  token_no_default_policy  = true
+  token_num_uses           = 10
+}
+`, role)
+}
+
+func testAccTokenAuthBackendRoleConfigInvalidCIDR(role string) string {
+	return fmt.Sprintf(`
+resource "vault_token_auth_backend_role" "role" {
+  role_name         = "%s"
+  token_bound_cidrs = ["10.0.0.0/8", "10.1.1.1"]
 }
 `, role)
 }
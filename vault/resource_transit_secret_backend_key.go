@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -20,6 +22,10 @@ var (
 	transitSecretBackendKeyNameFromPathRegex    = regexp.MustCompile("^.+/keys/(.+)$")
 )
 
+// transitAutoRotatePeriodMin is the minimum auto rotate period in seconds
+// accepted by Vault.
+const transitAutoRotatePeriodMin = 3600
+
 func transitSecretBackendKeyResource() *schema.Resource {
 	return &schema.Resource{
 		Create: transitSecretBackendKeyCreate,
@@ -82,6 +88,7 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				Deprecated:    "Use auto_rotate_period instead",
 				Description:   "Amount of time the key should live before being automatically rotated. A value of 0 disables automatic rotation for the key.",
 				ConflictsWith: []string{"auto_rotate_period"},
+				ValidateFunc:  validateTransitAutoRotatePeriod,
 			},
 			"auto_rotate_period": {
 				Type:          schema.TypeInt,
@@ -89,6 +96,7 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				Computed:      true,
 				Description:   "Amount of time the key should live before being automatically rotated. A value of 0 disables automatic rotation for the key.",
 				ConflictsWith: []string{"auto_rotate_interval"},
+				ValidateFunc:  validateTransitAutoRotatePeriod,
 			},
 			"type": {
 				Type:         schema.TypeString,
@@ -101,7 +109,7 @@ func transitSecretBackendKeyResource() *schema.Resource {
 			"keys": {
 				Type:        schema.TypeList,
 				Computed:    true,
-				Description: "List of key versions in the keyring.",
+				Description: "List of key versions in the keyring, ordered by version.",
 				Elem: &schema.Schema{
 					Type: schema.TypeMap,
 					Elem: schema.TypeString,
@@ -236,6 +244,13 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 	return transitSecretBackendKeyRead(d, meta)
 }
 
+// validateTransitAutoRotatePeriod ensures that the auto rotate period is either
+// 0, which disables automatic rotation, or at least Vault's minimum of one hour.
+var validateTransitAutoRotatePeriod = validation.Any(
+	validation.IntInSlice([]int{0}),
+	validation.IntAtLeast(transitAutoRotatePeriodMin),
+)
+
 func getTransitAutoRotatePeriod(d *schema.ResourceData) int {
 	var autoRotatePeriod int
 	v, ok := d.GetOkExists("auto_rotate_period")
@@ -314,19 +329,9 @@ func transitSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("expected min_encryption_version %q to be a number, and it isn't", secret.Data["min_encryption_version"])
 	}
 
-	ikeys := secret.Data["keys"].(map[string]interface{})
-	keys := []interface{}{}
-	for _, v := range ikeys {
-		// Data structure of "keys" differs depending on encryption key type. Sometimes it's a single integer hash,
-		// and other times it's a full map of values describing the key version's creation date, name, and public key.
-
-		if sv, ok := v.(map[string]interface{}); ok { // for key types of rsa-2048, rsa-3072, rsa-4096, ed25519, ecdsa-p256, ecdsa-p384 or ecdsa-p521
-			keys = append(keys, sv)
-		} else if sv, ok := v.(json.Number); ok { // for key types of aes128-gcm96, aes256-gcm96 or chacha20-poly1305
-			m := make(map[string]interface{})
-			m["id"] = sv
-			keys = append(keys, m)
-		}
+	keys, err := transitKeyVersions(secret.Data["keys"])
+	if err != nil {
+		return fmt.Errorf("error reading key versions of %q: %s", path, err)
 	}
 
 	if err := d.Set("keys", keys); err != nil {
@@ -384,12 +389,51 @@ func transitSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if err := set(autoRotatePeriodField, "auto_rotate_period"); err != nil {
-		return nil
+		return err
 	}
 
 	return nil
 }
 
+// transitKeyVersions returns the versions of a transit key, ordered by
+// version. The data structure of each version differs depending on the key
+// type: symmetric key types only return the creation time of the version,
+// which is set as "id", while asymmetric key types return a map of values
+// describing the version's creation time, name and public key.
+func transitKeyVersions(i interface{}) ([]interface{}, error) {
+	ikeys, ok := i.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected keys to be a map, got %T", i)
+	}
+
+	versions := make([]int, 0, len(ikeys))
+	for k := range ikeys {
+		v, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, fmt.Errorf("expected key version %q to be a number", k)
+		}
+		versions = append(versions, v)
+	}
+	sort.Ints(versions)
+
+	keys := make([]interface{}, 0, len(versions))
+	for _, version := range versions {
+		m := map[string]interface{}{}
+		switch v := ikeys[strconv.Itoa(version)].(type) {
+		case map[string]interface{}: // for key types of rsa-2048, rsa-3072, rsa-4096, ed25519, ecdsa-p256, ecdsa-p384 or ecdsa-p521
+			for k, val := range v {
+				m[k] = fmt.Sprintf("%v", val)
+			}
+		case json.Number: // for key types of aes128-gcm96, aes256-gcm96 or chacha20-poly1305
+			m["id"] = v.String()
+		}
+		m["version"] = strconv.Itoa(version)
+		keys = append(keys, m)
+	}
+
+	return keys, nil
+}
+
 func transitSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func transitSecretBackendKeyRestoreResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transitSecretBackendKeyRestoreCreate,
+		Read:   transitSecretBackendKeyRestoreRead,
+		Update: transitSecretBackendKeyRestoreRead,
+		Delete: transitSecretBackendKeyRestoreDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Transit secret backend to restore the key to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name to restore the key as.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"backup": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "The backup of the key, as returned by the vault_transit_backup data source.",
+				ValidateFunc: validation.StringIsNotEmpty,
+				// every backup of a key differs, so the key is only restored
+				// again when the triggers change.
+				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Description: "Arbitrary map of values that, when changed, will restore " +
+					"the key again.",
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "If set, an existing key with the same name is overwritten.",
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	name := d.Get("name").(string)
+
+	path := backend + "/restore/" + name
+	data := map[string]interface{}{
+		"backup": d.Get("backup").(string),
+		"force":  d.Get("force").(bool),
+	}
+
+	log.Printf("[DEBUG] Restoring transit key %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error restoring transit key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Restored transit key %q", path)
+
+	d.SetId(backend + "/keys/" + name)
+
+	return transitSecretBackendKeyRestoreRead(d, meta)
+}
+
+func transitSecretBackendKeyRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return e
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading restored transit key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading restored transit key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read restored transit key %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Restored transit key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+// transitSecretBackendKeyRestoreDelete only removes the resource from the
+// state, the restored key is left in place.
+func transitSecretBackendKeyRestoreDelete(_ *schema.ResourceData, _ interface{}) error {
+	return nil
+}
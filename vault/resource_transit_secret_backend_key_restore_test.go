@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTransitSecretBackendKeyRestore(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	restoreBackend := acctest.RandomWithPrefix("transit-restore")
+	resourceName := "vault_transit_secret_backend_key_restore.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyRestore_config(backend, restoreBackend, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", restoreBackend+"/keys/restored"),
+					resource.TestCheckResourceAttr(resourceName, "backend", restoreBackend),
+					resource.TestCheckResourceAttr(resourceName, "name", "restored"),
+					resource.TestCheckResourceAttr(resourceName, "force", "false"),
+					resource.TestCheckResourceAttrPair(
+						"data.vault_transit_decrypt.test", "plaintext",
+						"data.vault_transit_encrypt.test", "plaintext"),
+				),
+			},
+			{
+				// the key is only restored again when the triggers change.
+				Config: testTransitSecretBackendKeyRestore_config(backend, restoreBackend, `
+  force    = true
+  triggers = {
+    restored_on = "2022-06-01"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force", "true"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.restored_on", "2022-06-01"),
+					resource.TestCheckResourceAttrPair(
+						"data.vault_transit_decrypt.test", "plaintext",
+						"data.vault_transit_encrypt.test", "plaintext"),
+				),
+			},
+		},
+	})
+}
+
+func testTransitSecretBackendKeyRestore_config(backend, restoreBackend, extraConfig string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_mount" "restore" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend                = vault_mount.test.path
+  name                   = "test"
+  deletion_allowed       = true
+  exportable             = true
+  allow_plaintext_backup = true
+}
+
+data "vault_transit_backup" "test" {
+  backend = vault_mount.test.path
+  name    = vault_transit_secret_backend_key.test.name
+}
+
+resource "vault_transit_secret_backend_key_restore" "test" {
+  backend = vault_mount.restore.path
+  name    = "restored"
+  backup  = data.vault_transit_backup.test.backup
+  %s
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  plaintext = "foo"
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = vault_transit_secret_backend_key_restore.test.backend
+  key        = vault_transit_secret_backend_key_restore.test.name
+  ciphertext = data.vault_transit_encrypt.test.ciphertext
+}
+`, backend, restoreBackend, extraConfig)
+}
@@ -240,6 +240,56 @@ resource "vault_transit_secret_backend_key" "test" {
 `, path, name)
 }
 
+func TestTransitSecretBackendKey_autoRotatePeriod(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resourceName := "vault_transit_secret_backend_key.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testTransitSecretBackendKeyConfig_autoRotatePeriod(name, backend, 60),
+				ExpectError: regexp.MustCompile(`expected auto_rotate_period to be at least \(3600\), got 60`),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_autoRotatePeriod(name, backend, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_rotate_period", "3600"),
+				),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_autoRotatePeriod(name, backend, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_rotate_period", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testTransitSecretBackendKeyConfig_autoRotatePeriod(name, path string, period int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend            = vault_mount.transit.path
+  name               = "%s"
+  deletion_allowed   = true
+  auto_rotate_period = %d
+}
+`, path, name, period)
+}
+
 func testTransitSecretBackendKeyConfig_conflicts(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "transit" {
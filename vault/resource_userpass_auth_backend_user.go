@@ -0,0 +1,187 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+var userpassAuthBackendUserFromPathRegex = regexp.MustCompile("^auth/(.+)/users/([^/]+)$")
+
+func userpassAuthBackendUserResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "userpass",
+			Description: "Path to the userpass auth backend.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The username of the user.",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "The password of the user.",
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		CreateContext: userpassAuthBackendUserCreate,
+		UpdateContext: userpassAuthBackendUserUpdate,
+		ReadContext:   userpassAuthBackendUserRead,
+		DeleteContext: userpassAuthBackendUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: fields,
+	}
+}
+
+func userpassAuthBackendUserPath(backend, username string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/users/" + strings.Trim(username, "/")
+}
+
+func userpassAuthBackendUserPasswordPath(path string) string {
+	return path + "/password"
+}
+
+func userpassAuthBackendUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := userpassAuthBackendUserPath(d.Get("backend").(string), d.Get("username").(string))
+
+	data := map[string]interface{}{
+		"password": d.Get("password").(string),
+	}
+	updateTokenFields(d, data, true)
+
+	log.Printf("[DEBUG] Writing userpass user %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return diag.Errorf("error writing userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote userpass user %q", path)
+
+	d.SetId(path)
+
+	return userpassAuthBackendUserRead(ctx, d, meta)
+}
+
+func userpassAuthBackendUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	data := map[string]interface{}{}
+	updateTokenFields(d, data, false)
+	if len(data) > 0 {
+		log.Printf("[DEBUG] Updating userpass user %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return diag.Errorf("error updating userpass user %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated userpass user %q", path)
+	}
+
+	// rotate the password in place, leaving the rest of the user untouched.
+	if d.HasChange("password") {
+		passwordPath := userpassAuthBackendUserPasswordPath(path)
+		log.Printf("[DEBUG] Updating userpass user password %q", passwordPath)
+		if _, err := client.Logical().Write(passwordPath, map[string]interface{}{
+			"password": d.Get("password").(string),
+		}); err != nil {
+			return diag.Errorf("error updating userpass user password %q: %s", passwordPath, err)
+		}
+		log.Printf("[DEBUG] Updated userpass user password %q", passwordPath)
+	}
+
+	return userpassAuthBackendUserRead(ctx, d, meta)
+}
+
+func userpassAuthBackendUserRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	backend, username, err := userpassAuthBackendUserFromPath(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Reading userpass user %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return diag.Errorf("error reading userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read userpass user %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] userpass user %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("backend", backend); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("username", username); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := readTokenFields(d, resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func userpassAuthBackendUserDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting userpass user %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return diag.Errorf("error deleting userpass user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted userpass user %q", path)
+
+	return nil
+}
+
+func userpassAuthBackendUserFromPath(path string) (string, string, error) {
+	res := userpassAuthBackendUserFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return "", "", fmt.Errorf("invalid path %q for userpass auth backend user", path)
+	}
+
+	return res[1], res[2], nil
+}
@@ -0,0 +1,172 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccUserpassAuthBackendUser_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-userpass")
+	username := acctest.RandomWithPrefix("user")
+	resourceName := "vault_userpass_auth_backend_user.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutil.TestAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccUserpassAuthBackendUserCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserpassAuthBackendUserConfig_basic(backend, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "auth/"+backend+"/users/"+username),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "token_policies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "token_bound_cidrs.#", "0"),
+				),
+			},
+			{
+				Config: testAccUserpassAuthBackendUserConfig_tokenFields(backend, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "auth/"+backend+"/users/"+username),
+					resource.TestCheckResourceAttr(resourceName, "token_policies.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "token_bound_cidrs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "token_bound_cidrs.*", "10.0.0.0/8"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "token_bound_cidrs.*", "192.168.1.1/32"),
+					resource.TestCheckResourceAttr(resourceName, "token_explicit_max_ttl", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "token_num_uses", "10"),
+					resource.TestCheckResourceAttr(resourceName, "token_ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "token_max_ttl", "600"),
+				),
+			},
+			{
+				Config: testAccUserpassAuthBackendUserConfig_password(backend, username, "rotated-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "auth/"+backend+"/users/"+username),
+					resource.TestCheckResourceAttr(resourceName, "token_policies.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "token_num_uses", "10"),
+					testAccUserpassAuthBackendUserCheckLogin(backend, username, "rotated-secret"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config:      testAccUserpassAuthBackendUserConfig_invalidCIDR(backend, username),
+				ExpectError: regexp.MustCompile(`to be a valid CIDR block`),
+			},
+		},
+	})
+}
+
+func testAccUserpassAuthBackendUserCheckLogin(backend, username, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := testProvider.Meta().(*provider.ProviderMeta).GetClient().Clone()
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("auth/%s/login/%s", backend, username)
+		resp, err := client.Logical().Write(path, map[string]interface{}{
+			"password": password,
+		})
+		if err != nil {
+			return fmt.Errorf("error logging in to %q: %w", path, err)
+		}
+		if resp == nil || resp.Auth == nil {
+			return fmt.Errorf("expected auth info in response from %q", path)
+		}
+
+		return nil
+	}
+}
+
+func testAccUserpassAuthBackendUserCheckDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_userpass_auth_backend_user" {
+			continue
+		}
+
+		client, e := provider.GetClient(rs.Primary, testProvider.Meta())
+		if e != nil {
+			return e
+		}
+
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("userpass user %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccUserpassAuthBackendUserConfig_basic(backend, username string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_userpass_auth_backend_user" "test" {
+  backend        = vault_auth_backend.userpass.path
+  username       = "%s"
+  password       = "super-secret"
+  token_policies = ["dev"]
+}
+`, backend, username)
+}
+
+func testAccUserpassAuthBackendUserConfig_tokenFields(backend, username string) string {
+	return testAccUserpassAuthBackendUserConfig_password(backend, username, "super-secret")
+}
+
+func testAccUserpassAuthBackendUserConfig_password(backend, username, password string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_userpass_auth_backend_user" "test" {
+  backend                = vault_auth_backend.userpass.path
+  username               = "%s"
+  password               = "%s"
+  token_policies         = ["dev", "prod"]
+  token_bound_cidrs      = ["10.0.0.0/8", "192.168.1.1/32"]
+  token_explicit_max_ttl = 7200
+  token_num_uses         = 10
+  token_ttl              = 300
+  token_max_ttl          = 600
+}
+`, backend, username, password)
+}
+
+func testAccUserpassAuthBackendUserConfig_invalidCIDR(backend, username string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_userpass_auth_backend_user" "test" {
+  backend           = vault_auth_backend.userpass.path
+  username          = "%s"
+  password          = "super-secret"
+  token_bound_cidrs = ["10.0.0.0/33"]
+}
+`, backend, username)
+}
@@ -67,11 +67,22 @@ func DeleteSentinelPolicy(client *api.Client, policyType string, name string) er
 	return err
 }
 
+var sentinelEnforcementLevels = []string{"advisory", "soft-mandatory", "hard-mandatory"}
+
 func ValidateSentinelEnforcementLevel(v interface{}, k string) (ws []string, errs []error) {
-	value := v.(string)
-	if value != "advisory" && value != "soft-mandatory" && value != "hard-mandatory" {
-		errs = append(errs, fmt.Errorf("unexpected value %s", value))
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, level := range sentinelEnforcementLevels {
+		if value == level {
+			return
+		}
 	}
+
+	errs = append(errs, fmt.Errorf("expected %s to be one of %v, got %q", k, sentinelEnforcementLevels, value))
 	return
 }
 
@@ -105,6 +116,11 @@ func sentinelPolicyRead(policyType string, attributes []string, d *schema.Resour
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
+	if policy == nil {
+		log.Printf("[WARN] %s policy %s not found, removing from state", policyType, name)
+		d.SetId("")
+		return nil
+	}
 
 	for _, value := range attributes {
 		d.Set(value, policy[value])
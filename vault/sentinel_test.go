@@ -0,0 +1,44 @@
+package vault
+
+import (
+	"testing"
+)
+
+func TestValidateSentinelEnforcementLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       interface{}
+		wantErr bool
+	}{
+		{
+			name: "advisory",
+			v:    "advisory",
+		},
+		{
+			name: "soft-mandatory",
+			v:    "soft-mandatory",
+		},
+		{
+			name: "hard-mandatory",
+			v:    "hard-mandatory",
+		},
+		{
+			name:    "invalid",
+			v:       "mandatory",
+			wantErr: true,
+		},
+		{
+			name:    "not-a-string",
+			v:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := ValidateSentinelEnforcementLevel(tt.v, "enforcement_level")
+			if tt.wantErr != (len(errs) > 0) {
+				t.Errorf("ValidateSentinelEnforcementLevel() errs = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
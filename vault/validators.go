@@ -2,10 +2,14 @@ package vault
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gosimple/slug"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 )
@@ -16,6 +20,10 @@ var (
 	regexpPathLeading  = regexp.MustCompile(fmt.Sprintf(`^%s`, consts.PathDelim))
 	regexpPathTrailing = regexp.MustCompile(fmt.Sprintf(`%s$`, consts.PathDelim))
 	regexpPath         = regexp.MustCompile(fmt.Sprintf(`%s|%s`, regexpPathLeading, regexpPathTrailing))
+	regexpOID          = regexp.MustCompile(`^[0-2](\.(0|[1-9][0-9]*))+$`)
+	regexpARN          = regexp.MustCompile(`^arn:[a-z0-9-]+:[a-z0-9-]+:[a-z0-9-]*:[^:]*:.+$`)
+	regexpSPIFFETrust  = regexp.MustCompile(`^[a-z0-9._-]+$`)
+	regexpSPIFFEPath   = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
 )
 
 func validateStringSlug(i interface{}, k string) (s []string, es []error) {
@@ -44,6 +52,108 @@ func validateDuration(i interface{}, k string) (s []string, es []error) {
 	return
 }
 
+// validateDurationSeconds ensures that the value is either a number of seconds
+// or a duration string, e.g. "60" or "1m", as accepted by Vault.
+func validateDurationSeconds(i interface{}, k string) (s []string, es []error) {
+	if _, ok := i.(string); !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := parseutil.ParseDurationSecond(i); err != nil {
+		es = append(es, fmt.Errorf("expected '%s' to be a valid duration, got %q", k, i))
+	}
+	return
+}
+
+func validateCIDR(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(v); err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a valid CIDR block, got %q", k, v))
+	}
+	return
+}
+
+// validateOID ensures that the value is an ASN.1 object identifier in dotted notation, e.g. "1.2.3.4".
+func validateOID(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !regexpOID.MatchString(v) {
+		es = append(es, fmt.Errorf("expected %s to be a valid OID in dotted notation, got %q", k, v))
+	}
+	return
+}
+
+// validateARN ensures that the value is an AWS ARN, e.g. "arn:aws:iam::123456789012:role/foo".
+func validateARN(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !regexpARN.MatchString(v) {
+		es = append(es, fmt.Errorf("expected %s to be a valid ARN, got %q", k, v))
+	}
+	return
+}
+
+// validateURISAN ensures that the value is an absolute URI, e.g. "spiffe://example.org/workload".
+// SPIFFE IDs are additionally checked against the SPIFFE ID specification.
+func validateURISAN(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	u, err := url.Parse(v)
+	if err != nil || u.Scheme == "" {
+		es = append(es, fmt.Errorf("expected %s to be a valid URI with a scheme, got %q", k, v))
+		return
+	}
+
+	if u.Scheme == "spiffe" {
+		if err := validateSPIFFEID(u); err != nil {
+			es = append(es, fmt.Errorf("expected %s to be a valid SPIFFE ID, got %q: %w", k, v, err))
+		}
+	}
+	return
+}
+
+func validateSPIFFEID(u *url.URL) error {
+	if u.Opaque != "" || u.Host == "" {
+		return fmt.Errorf("trust domain is missing")
+	}
+	if u.User != nil || u.Port() != "" {
+		return fmt.Errorf("trust domain must not contain a port or user info")
+	}
+	if !regexpSPIFFETrust.MatchString(u.Host) {
+		return fmt.Errorf("trust domain must only contain lowercase letters, numbers, dots, dashes and underscores")
+	}
+	if u.RawQuery != "" || u.ForceQuery || u.Fragment != "" {
+		return fmt.Errorf("query and fragment components are not allowed")
+	}
+	if u.Path == "" {
+		return nil
+	}
+	for _, segment := range strings.Split(strings.TrimPrefix(u.Path, "/"), "/") {
+		if segment == "" || segment == "." || segment == ".." || !regexpSPIFFEPath.MatchString(segment) {
+			return fmt.Errorf("path segment %q is invalid", segment)
+		}
+	}
+	return nil
+}
+
 func validateNoTrailingSlash(i interface{}, k string) ([]string, []error) {
 	var errs []error
 	if err := validatePath(regexpPathTrailing, i, k); err != nil {
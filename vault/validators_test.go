@@ -119,3 +119,269 @@ func Test_validateNoLeadingTrailingSlashes(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateCIDR(t *testing.T) {
+	tests := []struct {
+		name    string
+		val     interface{}
+		wantErr bool
+	}{
+		{
+			name: "ipv4",
+			val:  "10.0.0.0/8",
+		},
+		{
+			name: "ipv4-host",
+			val:  "10.1.1.1/32",
+		},
+		{
+			name: "ipv6",
+			val:  "2001:db8::/32",
+		},
+		{
+			name:    "no-prefix",
+			val:     "10.1.1.1",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			val:     "foo",
+			wantErr: true,
+		},
+		{
+			name:    "invalid-type",
+			val:     1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateCIDR(tt.val, "test_property")
+			if tt.wantErr != (len(errs) > 0) {
+				t.Errorf("validateCIDR() errs = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateDurationSeconds(t *testing.T) {
+	tests := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{
+			name: "seconds",
+			i:    "60",
+		},
+		{
+			name: "duration",
+			i:    "1h30m",
+		},
+		{
+			name:    "invalid",
+			i:       "1 hour",
+			wantErr: true,
+		},
+		{
+			name:    "not-a-string",
+			i:       60,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateDurationSeconds(tt.i, "wrapping_ttl")
+			if tt.wantErr != (len(errs) > 0) {
+				t.Errorf("validateDurationSeconds() errs = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateOID(t *testing.T) {
+	tests := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			i:    "1.3.6.1.5.5.7.3.1",
+		},
+		{
+			name: "valid-zero-arc",
+			i:    "2.5.29.32.0",
+		},
+		{
+			name:    "single-arc",
+			i:       "1",
+			wantErr: true,
+		},
+		{
+			name:    "trailing-dot",
+			i:       "1.2.3.",
+			wantErr: true,
+		},
+		{
+			name:    "leading-zero",
+			i:       "1.02.3",
+			wantErr: true,
+		},
+		{
+			name:    "invalid-first-arc",
+			i:       "3.2.1",
+			wantErr: true,
+		},
+		{
+			name:    "not-a-number",
+			i:       "1.2.foo",
+			wantErr: true,
+		},
+		{
+			name:    "non-string",
+			i:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateOID(tt.i, "oid")
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateOID() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateURISAN(t *testing.T) {
+	tests := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{
+			name: "valid-spiffe",
+			i:    "spiffe://example.org/ns/default/sa/web",
+		},
+		{
+			name: "valid-spiffe-trust-domain-only",
+			i:    "spiffe://example.org",
+		},
+		{
+			name: "valid-urn",
+			i:    "urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+		},
+		{
+			name: "valid-https",
+			i:    "https://example.org/path?query=1",
+		},
+		{
+			name:    "no-scheme",
+			i:       "uri.test.domain",
+			wantErr: true,
+		},
+		{
+			name:    "spiffe-uppercase-trust-domain",
+			i:       "spiffe://Example.org/web",
+			wantErr: true,
+		},
+		{
+			name:    "spiffe-port",
+			i:       "spiffe://example.org:8080/web",
+			wantErr: true,
+		},
+		{
+			name:    "spiffe-user-info",
+			i:       "spiffe://user@example.org/web",
+			wantErr: true,
+		},
+		{
+			name:    "spiffe-query",
+			i:       "spiffe://example.org/web?foo=bar",
+			wantErr: true,
+		},
+		{
+			name:    "spiffe-fragment",
+			i:       "spiffe://example.org/web#foo",
+			wantErr: true,
+		},
+		{
+			name:    "spiffe-trailing-slash",
+			i:       "spiffe://example.org/web/",
+			wantErr: true,
+		},
+		{
+			name:    "spiffe-dot-segment",
+			i:       "spiffe://example.org/../web",
+			wantErr: true,
+		},
+		{
+			name:    "spiffe-missing-trust-domain",
+			i:       "spiffe:///web",
+			wantErr: true,
+		},
+		{
+			name:    "non-string",
+			i:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateURISAN(tt.i, "uri_sans.0")
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateURISAN() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateARN(t *testing.T) {
+	tests := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{
+			name: "valid-role",
+			i:    "arn:aws:iam::123456789012:role/foo",
+		},
+		{
+			name: "valid-aws-managed-policy",
+			i:    "arn:aws:iam::aws:policy/ReadOnlyAccess",
+		},
+		{
+			name: "valid-partition",
+			i:    "arn:aws-us-gov:iam::123456789012:policy/boundary",
+		},
+		{
+			name:    "missing-prefix",
+			i:       "aws:iam::123456789012:role/foo",
+			wantErr: true,
+		},
+		{
+			name:    "missing-resource",
+			i:       "arn:aws:iam::123456789012:",
+			wantErr: true,
+		},
+		{
+			name:    "name-only",
+			i:       "foo",
+			wantErr: true,
+		},
+		{
+			name:    "non-string",
+			i:       1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateARN(tt.i, "role_arns")
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("validateARN() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}